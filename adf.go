@@ -0,0 +1,111 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ADFNode is node of Atlassian Document Format used by Jira Cloud REST API v3
+// for rich text like description and comment body, document is root node of type "doc" with Version 1
+// https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+type ADFNode struct {
+	Type    string                 `json:"type"`
+	Version int                    `json:"version,omitempty"`
+	Text    string                 `json:"text,omitempty"`
+	Content []ADFNode              `json:"content,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+	Marks   []ADFMark              `json:"marks,omitempty"`
+}
+
+// ADFMark holds formatting of text node like "strong" or "link"
+type ADFMark struct {
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// NewADFDocument returns document with text as single paragraph, line breaks are kept as hard breaks
+func NewADFDocument(text string) *ADFNode {
+	paragraph := ADFNode{Type: "paragraph"}
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			paragraph.Content = append(paragraph.Content, ADFNode{Type: "hardBreak"})
+		}
+		if line != "" {
+			paragraph.Content = append(paragraph.Content, ADFNode{Type: "text", Text: line})
+		}
+	}
+
+	return &ADFNode{
+		Type:    "doc",
+		Version: 1,
+		Content: []ADFNode{paragraph},
+	}
+}
+
+// PlainText returns text of node and its children without formatting,
+// hard breaks and ends of blocks like paragraphs are returned as line breaks
+func (node ADFNode) PlainText() string {
+	var text strings.Builder
+	node.writePlainText(&text)
+	return strings.TrimRight(text.String(), "\n")
+}
+
+func (node ADFNode) writePlainText(text *strings.Builder) {
+	switch node.Type {
+	case "text":
+		text.WriteString(node.Text)
+		return
+	case "hardBreak":
+		text.WriteString("\n")
+		return
+	}
+
+	for _, child := range node.Content {
+		child.writePlainText(text)
+	}
+	if node.Type == "paragraph" || node.Type == "heading" || node.Type == "codeBlock" {
+		text.WriteString("\n")
+	}
+}
+
+// decodeTextOrADF decodes rich text field which is string in REST API v2 and ADF document in REST API v3,
+// text holds plain text of ADF document
+func decodeTextOrADF(data json.RawMessage) (text string, adf *ADFNode, err error) {
+	if len(data) == 0 || string(data) == "null" {
+		return "", nil, nil
+	}
+	if data[0] == '"' {
+		err = json.Unmarshal(data, &text)
+		return text, nil, err
+	}
+
+	adf = &ADFNode{}
+	err = json.Unmarshal(data, adf)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed decode ADF document")
+	}
+
+	return adf.PlainText(), adf, nil
+}
+
+// useADF reports if rich text is sent as ADF document, that's required by Jira Cloud REST API v3
+func (jira *Jira) useADF() bool {
+	return jira.isCloud() && strings.TrimRight(jira.apiBasePath(), "/") == APIv3BasePath
+}
+
+// richText returns text as ADF document if useADF, otherwise text is returned as is
+// adf takes precedence over text if set, empty text and nil adf return nil
+func (jira *Jira) richText(text string, adf *ADFNode) interface{} {
+	if adf != nil {
+		return adf
+	}
+	if text == "" {
+		return nil
+	}
+	if jira.useADF() {
+		return NewADFDocument(text)
+	}
+	return text
+}
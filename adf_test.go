@@ -0,0 +1,75 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testADF = `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"linux"}]}]}`
+
+func TestIssueFieldsUnmarshalEnvironmentADF(t *testing.T) {
+	var fields IssueFields
+	err := json.Unmarshal([]byte(`{"summary":"s","environment":`+testADF+`,"description":`+testADF+`}`), &fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields.Environment != "linux" || fields.EnvironmentADF == nil {
+		t.Errorf("unexpected environment %q %v", fields.Environment, fields.EnvironmentADF)
+	}
+	if fields.Description != "linux" || fields.DescriptionADF == nil {
+		t.Errorf("unexpected description %q %v", fields.Description, fields.DescriptionADF)
+	}
+
+	fields = IssueFields{}
+	err = json.Unmarshal([]byte(`{"environment":"linux"}`), &fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields.Environment != "linux" || fields.EnvironmentADF != nil {
+		t.Errorf("unexpected environment %q %v", fields.Environment, fields.EnvironmentADF)
+	}
+}
+
+func TestRichTextRequestsADF(t *testing.T) {
+	bodies := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies[r.URL.Path] = string(body)
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithDeployment(DeploymentCloud), WithAPIBasePath(APIv3BasePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = jira.CreateIssue(RequestCreateIssue{Fields: ModifyIssueFields{Environment: "linux"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"fields":{"environment":` + testADF + `}}` + "\n"
+	if got := bodies[APIv3BasePath+"/issue"]; got != want {
+		t.Errorf("create issue body\n got: %s\nwant: %s", got, want)
+	}
+
+	err = jira.CreateIssueLink("Blocks", "TEST-1", "TEST-2", "linux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var link struct {
+		Comment struct {
+			Body json.RawMessage `json:"body"`
+		} `json:"comment"`
+	}
+	err = json.Unmarshal([]byte(bodies[APIv3BasePath+"/issueLink"]), &link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(link.Comment.Body) != testADF {
+		t.Errorf("unexpected link comment body %s", link.Comment.Body)
+	}
+}
@@ -0,0 +1,106 @@
+package jirardeau
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// agileMaxIssues is max number of issues moved to sprint by one request
+const agileMaxIssues = 50
+
+// Sprint of agile board
+// State is one of "future", "active", "closed"
+type Sprint struct {
+	ID            int    `json:"id"`
+	Self          string `json:"self"`
+	Name          string `json:"name"`
+	State         string `json:"state"`
+	StartDate     string `json:"startDate"`
+	EndDate       string `json:"endDate"`
+	CompleteDate  string `json:"completeDate"`
+	OriginBoardID int    `json:"originBoardId"`
+	Goal          string `json:"goal"`
+}
+
+// GetSprints returns all sprints of board, see GetSprintsContext
+func (jira *Jira) GetSprints(boardID int) (sprints []Sprint, err error) {
+	return jira.GetSprintsContext(context.Background(), boardID)
+}
+
+// GetSprintsContext returns all sprints of board, pages are requested until ctx is canceled
+// https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/sprint-getAllSprints
+func (jira *Jira) GetSprintsContext(ctx context.Context, boardID int) (sprints []Sprint, err error) {
+	startAt := 0
+	for {
+		var result struct {
+			StartAt    int      `json:"startAt"`
+			MaxResults int      `json:"maxResults"`
+			IsLast     bool     `json:"isLast"`
+			Values     []Sprint `json:"values"`
+		}
+
+		parameters := url.Values{}
+		parameters.Add("startAt", strconv.Itoa(startAt))
+
+		relURL := fmt.Sprintf("/board/%d/sprint?%s", boardID, parameters.Encode())
+		resp, err := jira.requestAPI(ctx, AgileBasePath, "GET", relURL, nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed get sprints")
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed get sprints, failed to decode response")
+		}
+
+		sprints = append(sprints, result.Values...)
+		startAt += len(result.Values)
+		if result.IsLast || len(result.Values) == 0 {
+			break
+		}
+	}
+
+	return sprints, nil
+}
+
+// MoveIssuesToSprint moves issues to sprint, see MoveIssuesToSprintContext
+func (jira *Jira) MoveIssuesToSprint(sprintID int, issueKeys []string) error {
+	return jira.MoveIssuesToSprintContext(context.Background(), sprintID, issueKeys)
+}
+
+// MoveIssuesToSprintContext moves issues to sprint, issues are moved by batches of 50
+// and remaining batches are not sent once ctx is canceled
+// https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint-moveIssuesToSprint
+func (jira *Jira) MoveIssuesToSprintContext(ctx context.Context, sprintID int, issueKeys []string) error {
+	for start := 0; start < len(issueKeys); start += agileMaxIssues {
+		end := start + agileMaxIssues
+		if end > len(issueKeys) {
+			end = len(issueKeys)
+		}
+
+		request := struct {
+			Issues []string `json:"issues"`
+		}{
+			Issues: issueKeys[start:end],
+		}
+
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request)
+		if err != nil {
+			return errors.Wrap(err, "failed move issues to sprint")
+		}
+
+		_, err = jira.requestAPI(ctx, AgileBasePath, "POST", fmt.Sprintf("/sprint/%d/issue", sprintID), &buf, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed move issues to sprint")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package jirardeau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestAgileContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cancel()
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":1,"isLast":false,"values":[{"id":%d}]}`, requests-1, requests)
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = jira.GetSprintsContext(ctx, 1)
+	if !errors.Is(err, context.Canceled) || requests != 1 {
+		t.Errorf("GetSprintsContext: expected cancel after 1 request, got %d requests: %v", requests, err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	requests = 0
+	keys := make([]string, agileMaxIssues+1)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("TEST-%d", i+1)
+	}
+	err = jira.MoveIssuesToSprintContext(ctx, 1, keys)
+	if !errors.Is(err, context.Canceled) || requests != 1 {
+		t.Errorf("MoveIssuesToSprintContext: expected cancel after 1 request, got %d requests: %v", requests, err)
+	}
+}
@@ -0,0 +1,128 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Attachment holds file attached to Issue
+// Content holds URL to download attachment
+type Attachment struct {
+	ID        string `json:"id"`
+	Self      string `json:"self"`
+	Filename  string `json:"filename"`
+	Author    Author `json:"author"`
+	Created   string `json:"created"`
+	Size      int64  `json:"size"`
+	MimeType  string `json:"mimeType"`
+	Content   string `json:"content"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+// AddAttachment uploads content as file with filename to issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue/{issueIdOrKey}/attachments-addAttachment
+func (jira *Jira) AddAttachment(issueKey, filename string, content io.Reader) (attachment Attachment, err error) {
+	if filename == "" {
+		return attachment, errors.New("failed add attachment: filename is empty")
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return attachment, errors.Wrap(err, "failed add attachment")
+	}
+	_, err = io.Copy(part, content)
+	if err != nil {
+		return attachment, errors.Wrap(err, "failed add attachment, failed to read content")
+	}
+	err = writer.Close()
+	if err != nil {
+		return attachment, errors.Wrap(err, "failed add attachment")
+	}
+
+	header := http.Header{}
+	header.Set("content-type", writer.FormDataContentType())
+	header.Set("X-Atlassian-Token", "no-check")
+
+	resp, err := jira.requestWithHeader("POST", fmt.Sprintf("/issue/%s/attachments", issueKey), &buf, header)
+	if err != nil {
+		return attachment, errors.Wrap(err, "failed add attachment")
+	}
+
+	var attachments []Attachment
+	err = json.NewDecoder(resp.Body).Decode(&attachments)
+	if err != nil {
+		return attachment, errors.Wrap(err, "failed add attachment, failed to decode response")
+	}
+	if len(attachments) == 0 {
+		return attachment, errors.New("failed add attachment: empty response")
+	}
+
+	return attachments[0], nil
+}
+
+// GetAttachmentMeta returns attachment metadata without content
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/attachment-getAttachment
+func (jira *Jira) GetAttachmentMeta(attachmentID string) (attachment Attachment, err error) {
+	if attachmentID == "" {
+		return attachment, errors.New("failed get attachment: attachment ID is empty")
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/attachment/%s", attachmentID), nil)
+	if err != nil {
+		return attachment, errors.Wrap(err, "failed get attachment")
+	}
+
+	err = json.NewDecoder(resp).Decode(&attachment)
+	if err != nil {
+		return attachment, errors.Wrap(err, "failed get attachment, failed to decode response")
+	}
+
+	return attachment, nil
+}
+
+// GetAttachment returns content of attachment as stream, caller must close it
+// Content is not buffered so it's not retried on failures
+func (jira *Jira) GetAttachment(attachmentID string) (content io.ReadCloser, err error) {
+	attachment, err := jira.GetAttachmentMeta(attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	jira.logger().Info("STRT", "GET", attachment.Content)
+	start := time.Now()
+
+	req, err := http.NewRequest("GET", attachment.Content, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed get attachment, failed to build HTTP request GET %s", attachment.Content)
+	}
+	err = jira.setHeaders(req, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get attachment")
+	}
+
+	resp, err := jira.client().Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed get attachment, failed to JIRA request GET %s", attachment.Content)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		var buf bytes.Buffer
+		buf.ReadFrom(resp.Body)
+		err = newAPIError("GET", attachment.Content, resp.StatusCode, buf.Bytes())
+		jira.logger().Error(err, "in", elapsed(start))
+		return nil, errors.Wrap(err, "failed get attachment")
+	}
+
+	jira.logger().Info("DONE", "GET", attachment.Content, elapsed(start))
+
+	return resp.Body, nil
+}
@@ -0,0 +1,149 @@
+package jirardeau
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// IssuesError holds errors of bulk operation per issue key
+type IssuesError map[string]error
+
+// Error returns errors of all issues sorted by key
+func (e IssuesError) Error() string {
+	keys := make([]string, 0, len(e))
+	for key := range e {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	errs := make([]error, 0, len(keys))
+	for _, key := range keys {
+		errs = append(errs, e[key])
+	}
+
+	return keyedErrorsMessage("issues", keys, errs)
+}
+
+// concurrency returns Concurrency or DefaultConcurrency if not set
+func (jira *Jira) concurrency() int {
+	if jira.Concurrency > 0 {
+		return jira.Concurrency
+	}
+	return DefaultConcurrency
+}
+
+// GetIssuesByKeys fetches issues by keys concurrently, see GetIssuesByKeysContext
+func (jira *Jira) GetIssuesByKeys(keys []string, expand []string) (issues map[string]Issue, err error) {
+	return jira.GetIssuesByKeysContext(context.Background(), keys, expand)
+}
+
+// GetIssuesByKeysContext fetches issues by keys with at most Concurrency parallel requests
+// Fetched issues are returned along with IssuesError holding errors of failed keys,
+// keys not fetched because of ctx cancellation are reported with ctx error
+func (jira *Jira) GetIssuesByKeysContext(ctx context.Context, keys []string, expand []string) (issues map[string]Issue, err error) {
+	issues = make(map[string]Issue)
+
+	var mu sync.Mutex
+	issuesErr := jira.forEachKey(ctx, keys, func(key string) error {
+		issue, _, err := jira.getIssue(ctx, key, nil, expand, nil)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		issues[key] = issue
+		mu.Unlock()
+		return nil
+	})
+	if len(issuesErr) > 0 {
+		return issues, issuesErr
+	}
+
+	return issues, nil
+}
+
+// UpdateIssuesByJQL applies update to all issues found by jql, see UpdateIssuesByJQLContext
+func (jira *Jira) UpdateIssuesByJQL(jql string, update RequestUpdateIssue) (updated []string, err error) {
+	return jira.UpdateIssuesByJQLContext(context.Background(), jql, update)
+}
+
+// UpdateIssuesByJQLContext applies fields and update operations of update to all issues found by jql
+// with at most Concurrency parallel requests, Key of update is ignored.
+// Keys of updated issues are returned along with IssuesError holding errors of failed keys,
+// keys not updated because of ctx cancellation are reported with ctx error
+func (jira *Jira) UpdateIssuesByJQLContext(ctx context.Context, jql string, update RequestUpdateIssue) (updated []string, err error) {
+	found, err := jira.SearchJQLContext(ctx, jql, []string{"key"}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed update issues by JQL")
+	}
+
+	keys := make([]string, 0, len(found))
+	for _, issue := range found {
+		keys = append(keys, issue.Key)
+	}
+
+	var mu sync.Mutex
+	issuesErr := jira.forEachKey(ctx, keys, func(key string) error {
+		request := update
+		request.Key = key
+		err := jira.updateIssue(ctx, request)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		updated = append(updated, key)
+		mu.Unlock()
+		return nil
+	})
+	sort.Strings(updated)
+	if len(issuesErr) > 0 {
+		return updated, issuesErr
+	}
+
+	return updated, nil
+}
+
+// forEachKey calls fn for keys with at most Concurrency parallel calls
+// and returns errors of failed keys, keys skipped because of ctx cancellation get ctx error
+func (jira *Jira) forEachKey(ctx context.Context, keys []string, fn func(key string) error) IssuesError {
+	issuesErr := make(IssuesError)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan string)
+
+	for i := 0; i < jira.concurrency(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range queue {
+				err := fn(key)
+				if err != nil {
+					mu.Lock()
+					issuesErr[key] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i, key := range keys {
+		select {
+		case queue <- key:
+			continue
+		case <-ctx.Done():
+		}
+		mu.Lock()
+		for _, skipped := range keys[i:] {
+			issuesErr[skipped] = ctx.Err()
+		}
+		mu.Unlock()
+		break
+	}
+	close(queue)
+	wg.Wait()
+
+	return issuesErr
+}
@@ -0,0 +1,43 @@
+package jirardeau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestUpdateIssuesByJQLContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var searches, updates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			updates++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		searches++
+		// search is canceled after first page of many
+		cancel()
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":1,"total":100,"issues":[{"key":"TEST-%d"}]}`, searches-1, searches)
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = jira.UpdateIssuesByJQLContext(ctx, "project = TEST", RequestUpdateIssue{Fields: ModifyIssueFields{Summary: "s"}})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got %v", err)
+	}
+	if searches != 1 || updates != 0 {
+		t.Errorf("got %d searches and %d updates after cancel, want 1 and 0", searches, updates)
+	}
+}
@@ -0,0 +1,28 @@
+package jirardeau
+
+// Changelog holds history of issue changes
+type Changelog struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Histories  []History `json:"histories"`
+}
+
+// History holds changes of issue made by Author at once
+type History struct {
+	ID      string       `json:"id"`
+	Author  Author       `json:"author"`
+	Created string       `json:"created"`
+	Items   []ChangeItem `json:"items"`
+}
+
+// ChangeItem holds change of single field
+// From and To hold ids of values like status id, FromString and ToString hold displayed values
+type ChangeItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
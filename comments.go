@@ -0,0 +1,210 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCommentNotFound returned by GetComment if issue has no comment with given id
+var ErrCommentNotFound = errors.New("comment not found")
+
+// requestComment holds body of comment create/update requests
+// Body is string or ADF document
+type requestComment struct {
+	Body       interface{} `json:"body"`
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// GetComments returns page of comments of issue
+// orderBy is field to sort comments by like "created", "-created" sorts newest first, empty uses JIRA default
+// maxResults is JIRA default if zero
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-getComments
+func (jira *Jira) GetComments(issueKey string, startAt, maxResults int, orderBy string) (comments CommentField, err error) {
+	parameters := url.Values{}
+	parameters.Add("startAt", strconv.Itoa(startAt))
+	if maxResults > 0 {
+		parameters.Add("maxResults", strconv.Itoa(maxResults))
+	}
+	if orderBy != "" {
+		parameters.Add("orderBy", orderBy)
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/issue/%s/comment?%s", issueKey, parameters.Encode()), nil)
+	if err != nil {
+		return comments, errors.Wrap(err, "failed get comments")
+	}
+
+	err = json.NewDecoder(resp).Decode(&comments)
+	if err != nil {
+		return comments, errors.Wrap(err, "failed get comments, failed to decode response")
+	}
+
+	return comments, nil
+}
+
+// GetComment returns comment of issue by id
+// ErrCommentNotFound is returned if issue exists but comment doesn't, error matches ErrIssueNotFound if issue doesn't exist
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-getComment
+func (jira *Jira) GetComment(issueKey, commentID string) (comment Comment, err error) {
+	if commentID == "" {
+		return comment, errors.New("failed get comment: comment ID is empty")
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/issue/%s/comment/%s", issueKey, commentID), nil)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			if isIssueNotFound(apiErr) {
+				return comment, errors.Wrap(notFoundError(err, "issue", issueKey), "failed get comment")
+			}
+			return comment, errors.Wrapf(ErrCommentNotFound, "failed get comment %s of issue %s", commentID, issueKey)
+		}
+		return comment, errors.Wrap(err, "failed get comment")
+	}
+
+	err = json.NewDecoder(resp).Decode(&comment)
+	if err != nil {
+		return comment, errors.Wrap(err, "failed get comment, failed to decode response")
+	}
+
+	return comment, nil
+}
+
+// AddComment adds comment to issue and returns created comment
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-addComment
+func (jira *Jira) AddComment(issueKey, body string) (comment Comment, err error) {
+	return jira.AddCommentWithVisibility(issueKey, body, nil)
+}
+
+// AddCommentWithVisibility adds comment visible only to role or group, nil visibility adds public comment
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-addComment
+// body is sent as ADF document on Cloud with APIv3BasePath
+func (jira *Jira) AddCommentWithVisibility(issueKey, body string, visibility *Visibility) (comment Comment, err error) {
+	return jira.addComment(issueKey, jira.richText(body, nil), visibility)
+}
+
+// AddCommentADF adds comment with body in ADF, nil visibility adds public comment
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-addComment
+func (jira *Jira) AddCommentADF(issueKey string, body *ADFNode, visibility *Visibility) (comment Comment, err error) {
+	if body == nil {
+		return comment, errors.New("failed add comment: body is nil")
+	}
+	return jira.addComment(issueKey, body, visibility)
+}
+
+func (jira *Jira) addComment(issueKey string, body interface{}, visibility *Visibility) (comment Comment, err error) {
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(requestComment{Body: body, Visibility: visibility})
+	if err != nil {
+		return comment, errors.Wrap(err, "failed add comment")
+	}
+
+	resp, err := jira.request("POST", fmt.Sprintf("/issue/%s/comment", issueKey), &buf)
+	if err != nil {
+		return comment, errors.Wrap(err, "failed add comment")
+	}
+
+	err = json.NewDecoder(resp).Decode(&comment)
+	if err != nil {
+		return comment, errors.Wrap(err, "failed add comment, failed to decode response")
+	}
+
+	return comment, nil
+}
+
+// CommentsError holds errors of AddComments per index of comment body
+type CommentsError map[int]error
+
+// Error returns errors of all comments sorted by index
+func (e CommentsError) Error() string {
+	indexes := make([]int, 0, len(e))
+	for index := range e {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	keys := make([]string, 0, len(indexes))
+	errs := make([]error, 0, len(indexes))
+	for _, index := range indexes {
+		keys = append(keys, strconv.Itoa(index))
+		errs = append(errs, e[index])
+	}
+
+	return keyedErrorsMessage("comments", keys, errs)
+}
+
+// AddComments adds comments to issue one by one in order of bodies,
+// comments[i] is comment created for bodies[i] or zero Comment if it's failed or not posted
+// JIRA has no bulk endpoint for comments so they are posted sequentially.
+// On failure posting is stopped and error is returned,
+// if continueOnError is true remaining bodies are posted and CommentsError holding errors by index of body is returned
+func (jira *Jira) AddComments(issueKey string, bodies []string, continueOnError bool) (comments []Comment, err error) {
+	comments = make([]Comment, len(bodies))
+	commentsErr := make(CommentsError)
+	for i, body := range bodies {
+		comment, err := jira.AddComment(issueKey, body)
+		if err != nil {
+			if !continueOnError {
+				return comments, errors.Wrapf(err, "failed add comments, failed comment %d", i)
+			}
+			commentsErr[i] = err
+			continue
+		}
+		comments[i] = comment
+	}
+
+	if len(commentsErr) > 0 {
+		return comments, commentsErr
+	}
+
+	return comments, nil
+}
+
+// UpdateComment replaces body of existed comment and returns updated comment
+// body is sent as ADF document on Cloud with APIv3BasePath
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-updateComment
+func (jira *Jira) UpdateComment(issueKey, commentID, body string) (comment Comment, err error) {
+	if commentID == "" {
+		return comment, errors.New("failed update comment: comment ID is empty")
+	}
+
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(requestComment{Body: jira.richText(body, nil)})
+	if err != nil {
+		return comment, errors.Wrap(err, "failed update comment")
+	}
+
+	resp, err := jira.request("PUT", fmt.Sprintf("/issue/%s/comment/%s", issueKey, commentID), &buf)
+	if err != nil {
+		return comment, errors.Wrap(err, "failed update comment")
+	}
+
+	err = json.NewDecoder(resp).Decode(&comment)
+	if err != nil {
+		return comment, errors.Wrap(err, "failed update comment, failed to decode response")
+	}
+
+	return comment, nil
+}
+
+// DeleteComment removes comment from issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-deleteComment
+func (jira *Jira) DeleteComment(issueKey, commentID string) error {
+	if commentID == "" {
+		return errors.New("failed delete comment: comment ID is empty")
+	}
+
+	_, err := jira.request("DELETE", fmt.Sprintf("/issue/%s/comment/%s", issueKey, commentID), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed delete comment")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddComments(t *testing.T) {
+	var created int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Body string `json:"body"`
+		}
+		json.NewDecoder(r.Body).Decode(&request)
+		if request.Body == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errorMessages":["Comment body can not be empty!"],"errors":{}}`))
+			return
+		}
+		created++
+		fmt.Fprintf(w, `{"id":"%d","body":%q}`, created, request.Body)
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bodies := []string{"first", "fail", "third"}
+
+	comments, err := jira.AddComments("TEST-1", bodies, true)
+	commentsErr, ok := err.(CommentsError)
+	if !ok || len(commentsErr) != 1 || commentsErr[1] == nil {
+		t.Fatalf("expected CommentsError of comment 1, got %v", err)
+	}
+	if len(comments) != len(bodies) {
+		t.Fatalf("got %d comments, want %d", len(comments), len(bodies))
+	}
+	if comments[0].Body != "first" || comments[1].ID != "" || comments[2].Body != "third" {
+		t.Errorf("comments don't match bodies: %+v", comments)
+	}
+
+	comments, err = jira.AddComments("TEST-1", bodies, false)
+	if err == nil {
+		t.Fatal("expected error of comment 1")
+	}
+	if len(comments) != len(bodies) || comments[0].Body != "first" || comments[1].ID != "" || comments[2].ID != "" {
+		t.Errorf("comments don't match bodies: %+v", comments)
+	}
+}
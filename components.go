@@ -0,0 +1,33 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Component of Project
+// Component is referenced by ID or Name when issue is created or updated
+type Component struct {
+	ID          string `json:"id,omitempty"`
+	Self        string `json:"self,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetComponents returns components of Jira.Project
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/project-getProjectComponents
+func (jira *Jira) GetComponents() (components []Component, err error) {
+	resp, err := jira.request("GET", fmt.Sprintf("/project/%s/components", jira.Project), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get components")
+	}
+
+	err = json.NewDecoder(resp).Decode(&components)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get components, failed to decode response")
+	}
+
+	return components, nil
+}
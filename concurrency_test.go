@@ -0,0 +1,155 @@
+package jirardeau_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oneumyvakin/jirardeau"
+	"github.com/oneumyvakin/jirardeau/jiratest"
+)
+
+const concurrentCalls = 50
+
+func TestConcurrentGetIssue(t *testing.T) {
+	server := jiratest.NewServer()
+	defer server.Close()
+	server.AddIssue("TEST-1", []byte(`{"id":"10000","key":"TEST-1","fields":{"summary":"s"}}`))
+	server.AddIssue("TEST-2", []byte(`{"id":"10001","key":"TEST-2","fields":{"summary":"s"}}`))
+
+	jira := server.Jira()
+	jira.RetryPolicy = &jirardeau.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("TEST-%d", i%2+1)
+			issue, err := jira.GetIssue(key, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if issue.Key != key {
+				t.Errorf("got issue %s, want %s", issue.Key, key)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// cacheServer serves session, versions, fields and issues,
+// first session expires after expireAfter requests authenticated by it
+type cacheServer struct {
+	mu          sync.Mutex
+	sessions    map[string]bool
+	expireAfter int
+	requests    map[string]int
+}
+
+func (server *cacheServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if r.URL.Path == jirardeau.AuthBasePath+"/session" {
+		session := fmt.Sprintf("session-%d", len(server.sessions)+1)
+		server.sessions[session] = true
+		fmt.Fprintf(w, `{"session":{"name":"JSESSIONID","value":%q}}`, session)
+		return
+	}
+
+	cookie, err := r.Cookie("JSESSIONID")
+	if err != nil || !server.sessions[cookie.Value] {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	server.requests[r.URL.Path]++
+	if cookie.Value == "session-1" {
+		server.expireAfter--
+		if server.expireAfter <= 0 {
+			server.sessions[cookie.Value] = false
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/version"):
+		w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{"id":"10000","name":"1.0"}]}`))
+	case strings.HasSuffix(r.URL.Path, "/field"):
+		w.Write([]byte(`[{"id":"customfield_10001","name":"Story Points","custom":true}]`))
+	default:
+		w.Write([]byte(`{"id":"10000","key":"TEST-1","fields":{"summary":"s"}}`))
+	}
+}
+
+func (server *cacheServer) count(suffix string) (count int) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	for path, requests := range server.requests {
+		if strings.HasSuffix(path, suffix) {
+			count += requests
+		}
+	}
+	return
+}
+
+func TestConcurrentCachesAndSession(t *testing.T) {
+	handler := &cacheServer{
+		sessions:    make(map[string]bool),
+		expireAfter: concurrentCalls / 2,
+		requests:    make(map[string]int),
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	jira, err := jirardeau.NewJira(server.URL, jirardeau.WithProject("TEST"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jira.FixVersionsCacheTTL = time.Minute
+	err = jira.Authenticate("jdoe", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := jira.GetIssue("TEST-1", nil); err != nil {
+				t.Error(err)
+			}
+			if _, err := jira.GetFixVersions(); err != nil {
+				t.Error(err)
+			}
+			if id, err := jira.CustomFieldID("Story Points"); err != nil || id != "customfield_10001" {
+				t.Errorf("unexpected custom field id %q: %v", id, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	versions, fields := handler.count("/version"), handler.count("/field")
+	if _, err := jira.GetFixVersions(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jira.CustomFieldID("Story Points"); err != nil {
+		t.Fatal(err)
+	}
+	if handler.count("/version") != versions || handler.count("/field") != fields {
+		t.Error("cached versions or fields are requested again")
+	}
+
+	handler.mu.Lock()
+	sessions := len(handler.sessions)
+	handler.mu.Unlock()
+	if sessions < 2 {
+		t.Error("expired session is not renewed")
+	}
+}
@@ -0,0 +1,171 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CreateMeta describes fields of issue type which can be set on issue creation
+type CreateMeta struct {
+	ProjectKey string
+	IssueType  IssueType
+	Fields     map[string]FieldMeta
+}
+
+// FieldMeta describes field of issue type
+// AllowedValues is set for fields with predefined values like select lists, versions, components
+type FieldMeta struct {
+	Required        bool           `json:"required"`
+	Name            string         `json:"name"`
+	Key             string         `json:"key"`
+	HasDefaultValue bool           `json:"hasDefaultValue"`
+	Operations      []string       `json:"operations"`
+	Schema          FieldSchema    `json:"schema"`
+	AllowedValues   []AllowedValue `json:"allowedValues"`
+}
+
+// FieldSchema describes type of field value
+// Items holds type of array elements, Custom holds type of custom field
+type FieldSchema struct {
+	Type     string `json:"type"`
+	Items    string `json:"items"`
+	System   string `json:"system"`
+	Custom   string `json:"custom"`
+	CustomID int    `json:"customId"`
+}
+
+// AllowedValue holds value allowed for field
+// Select list options have Value, other values like versions have Name
+type AllowedValue struct {
+	ID    string `json:"id"`
+	Self  string `json:"self"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GetCreateMeta returns fields available for creating issue of issue type in project
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-getCreateIssueMeta
+func (jira *Jira) GetCreateMeta(projectKey, issueTypeID string) (meta CreateMeta, err error) {
+	if projectKey == "" || issueTypeID == "" {
+		return meta, errors.New("failed get create meta: project Key or issue type ID is empty")
+	}
+
+	var result struct {
+		Projects []struct {
+			Key        string `json:"key"`
+			IssueTypes []struct {
+				IssueType
+				Fields map[string]FieldMeta `json:"fields"`
+			} `json:"issuetypes"`
+		} `json:"projects"`
+	}
+
+	parameters := url.Values{}
+	parameters.Add("projectKeys", projectKey)
+	parameters.Add("issuetypeIds", issueTypeID)
+	parameters.Add("expand", "projects.issuetypes.fields")
+
+	resp, err := jira.request("GET", fmt.Sprintf("/issue/createmeta?%s", parameters.Encode()), nil)
+	if err != nil {
+		return meta, errors.Wrap(err, "failed get create meta")
+	}
+
+	err = json.NewDecoder(resp).Decode(&result)
+	if err != nil {
+		return meta, errors.Wrap(err, "failed get create meta, failed to decode response")
+	}
+
+	for _, project := range result.Projects {
+		for _, issueType := range project.IssueTypes {
+			if issueType.ID != issueTypeID {
+				continue
+			}
+			meta.ProjectKey = project.Key
+			meta.IssueType = issueType.IssueType
+			meta.Fields = issueType.Fields
+			return meta, nil
+		}
+	}
+
+	return meta, errors.Errorf("failed get create meta: issue type %s is not available in project %s", issueTypeID, projectKey)
+}
+
+// ValidateCreateIssue checks that request sets all required fields and only fields available on creation
+// Create meta is requested once per project and issue type and cached
+func (jira *Jira) ValidateCreateIssue(request RequestCreateIssue) error {
+	projectKey := jira.Project
+	if request.Fields.Project != nil && request.Fields.Project.Key != "" {
+		projectKey = request.Fields.Project.Key
+	}
+	if request.Fields.IssueType == nil || request.Fields.IssueType.ID == "" {
+		return errors.New("invalid create issue request: issue type ID is empty")
+	}
+
+	meta, err := jira.cachedCreateMeta(projectKey, request.Fields.IssueType.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed validate create issue request")
+	}
+
+	// fields are checked as sent by CreateIssue, e.g. with custom fields names resolved to ids
+	requestFields, err := jira.requestFields(request.Fields)
+	if err != nil {
+		return errors.Wrap(err, "failed validate create issue request")
+	}
+	data, err := json.Marshal(requestFields)
+	if err != nil {
+		return errors.Wrap(err, "failed validate create issue request")
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return errors.Wrap(err, "failed validate create issue request")
+	}
+
+	var problems []string
+	for key, field := range meta.Fields {
+		if _, ok := fields[key]; !ok && field.Required && !field.HasDefaultValue {
+			problems = append(problems, fmt.Sprintf("field %s (%s) is required", key, field.Name))
+		}
+	}
+	for key := range fields {
+		if _, ok := meta.Fields[key]; !ok {
+			problems = append(problems, fmt.Sprintf("field %s cannot be set", key))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return errors.Errorf("invalid create issue request: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func (jira *Jira) cachedCreateMeta(projectKey, issueTypeID string) (meta CreateMeta, err error) {
+	cacheKey := projectKey + "/" + issueTypeID
+
+	jira.mu.Lock()
+	meta, ok := jira.createMetaCache[cacheKey]
+	jira.mu.Unlock()
+	if ok {
+		return meta, nil
+	}
+
+	meta, err = jira.GetCreateMeta(projectKey, issueTypeID)
+	if err != nil {
+		return meta, err
+	}
+
+	jira.mu.Lock()
+	if jira.createMetaCache == nil {
+		jira.createMetaCache = make(map[string]CreateMeta)
+	}
+	jira.createMetaCache[cacheKey] = meta
+	jira.mu.Unlock()
+
+	return meta, nil
+}
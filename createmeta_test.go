@@ -0,0 +1,45 @@
+package jirardeau
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateCreateIssueCustomFieldByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/field") {
+			w.Write([]byte(`[{"id":"summary","name":"Summary"},{"id":"customfield_10001","name":"Team","custom":true}]`))
+			return
+		}
+		w.Write([]byte(`{"projects":[{"key":"TEST","issuetypes":[{"id":"1","name":"Bug","fields":{
+			"project":{"required":true,"name":"Project"},
+			"issuetype":{"required":true,"name":"Issue Type"},
+			"summary":{"required":true,"name":"Summary"},
+			"customfield_10001":{"required":true,"name":"Team"}
+		}}]}]}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithProject("TEST"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := RequestCreateIssue{Fields: ModifyIssueFields{
+		Project:   &Project{Key: "TEST"},
+		IssueType: &IssueType{ID: "1"},
+		Summary:   "s",
+	}}
+	err = jira.ValidateCreateIssue(request)
+	if err == nil || !strings.Contains(err.Error(), "customfield_10001") {
+		t.Errorf("expected error of missing customfield_10001, got %v", err)
+	}
+
+	request.Fields.SetCustomFieldByName("Team", CustomFieldValue{Value: "Core"})
+	err = jira.ValidateCreateIssue(request)
+	if err != nil {
+		t.Errorf("ValidateCreateIssue: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package jirardeau
+
+import (
+	"sort"
+	"strings"
+)
+
+// FieldChange holds old and new value of field changed between two issues
+// Field is field ID like "summary", "fixVersions" or "customfield_10001"
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// DiffIssues returns fields changed from issue a to issue b
+// Summary, status, assignee, fix versions, labels, description and custom fields are compared,
+// fix versions and labels are compared regardless of order
+// Issue without Fields is compared as issue with empty fields
+func DiffIssues(a, b Issue) (changes []FieldChange) {
+	if a.Fields == nil {
+		a.Fields = &IssueFields{}
+	}
+	if b.Fields == nil {
+		b.Fields = &IssueFields{}
+	}
+
+	diff := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, FieldChange{Field: field, Old: old, New: new})
+		}
+	}
+
+	diff("summary", a.Fields.Summary, b.Fields.Summary)
+	diff("status", a.Fields.Status.Name, b.Fields.Status.Name)
+	diff("assignee", userString(a.Fields.Assignee), userString(b.Fields.Assignee))
+	diff("fixVersions", fixVersionsString(a.Fields.FixVersions), fixVersionsString(b.Fields.FixVersions))
+	diff("labels", sortedString(a.Fields.Labels), sortedString(b.Fields.Labels))
+	diff("description", a.Fields.Description, b.Fields.Description)
+
+	ids := make(map[string]bool)
+	for id := range a.Fields.CustomFieldValues {
+		ids[id] = true
+	}
+	for id := range b.Fields.CustomFieldValues {
+		ids[id] = true
+	}
+	sortedIDs := make([]string, 0, len(ids))
+	for id := range ids {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Strings(sortedIDs)
+
+	for _, id := range sortedIDs {
+		diff(id, customFieldString(a.Fields.CustomFieldValues[id]), customFieldString(b.Fields.CustomFieldValues[id]))
+	}
+
+	return
+}
+
+// userString returns name of user or account id on Cloud
+func userString(user *Author) string {
+	if user == nil {
+		return ""
+	}
+	if user.Name != "" {
+		return user.Name
+	}
+
+	return user.AccountID
+}
+
+// fixVersionsString returns sorted names of fix versions separated by comma
+func fixVersionsString(fixVersions []*FixVersion) string {
+	names := make([]string, 0, len(fixVersions))
+	for _, fixVersion := range fixVersions {
+		if fixVersion != nil {
+			names = append(names, fixVersion.Name)
+		}
+	}
+
+	return sortedString(names)
+}
+
+// sortedString returns sorted values separated by comma without modifying values
+func sortedString(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, ", ")
+}
+
+// customFieldString returns value of custom field with multiple values sorted
+// and child option of cascading select after parent one
+func customFieldString(value CustomFieldValue) string {
+	if value.Values != nil {
+		return sortedString(value.Values)
+	}
+	if value.Child != "" {
+		return value.Value + " - " + value.Child
+	}
+
+	return value.Value
+}
@@ -0,0 +1,65 @@
+package jirardeau
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffIssues(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Issue
+		want []FieldChange
+	}{
+		{
+			name: "nil fields",
+			a:    Issue{Key: "TEST-1"},
+			b:    Issue{Key: "TEST-1"},
+		},
+		{
+			name: "nil fields and summary",
+			a:    Issue{Key: "TEST-1"},
+			b:    Issue{Key: "TEST-1", Fields: &IssueFields{Summary: "new"}},
+			want: []FieldChange{{Field: "summary", Old: "", New: "new"}},
+		},
+		{
+			name: "labels and fix versions in other order",
+			a: Issue{Fields: &IssueFields{
+				Labels:      []string{"a", "b"},
+				FixVersions: []*FixVersion{{Name: "1.0"}, {Name: "2.0"}},
+			}},
+			b: Issue{Fields: &IssueFields{
+				Labels:      []string{"b", "a"},
+				FixVersions: []*FixVersion{{Name: "2.0"}, {Name: "1.0"}},
+			}},
+		},
+		{
+			name: "changed fields",
+			a: Issue{Fields: &IssueFields{
+				Status:            Status{Name: "Open"},
+				Labels:            []string{"a"},
+				CustomFieldValues: map[string]CustomFieldValue{"customfield_10001": {Value: "x"}},
+			}},
+			b: Issue{Fields: &IssueFields{
+				Status:            Status{Name: "Closed"},
+				Assignee:          &Author{Name: "jdoe"},
+				Labels:            []string{"b", "a"},
+				CustomFieldValues: map[string]CustomFieldValue{"customfield_10002": {Values: []string{"y", "x"}}},
+			}},
+			want: []FieldChange{
+				{Field: "status", Old: "Open", New: "Closed"},
+				{Field: "assignee", Old: "", New: "jdoe"},
+				{Field: "labels", Old: "a", New: "a, b"},
+				{Field: "customfield_10001", Old: "x", New: ""},
+				{Field: "customfield_10002", Old: "", New: "x, y"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got := DiffIssues(test.a, test.b)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// statusDescriptions holds human readable descriptions of HTTP codes returned by JIRA
+var statusDescriptions = map[int]string{
+	401: "Unauthorized (401)",
+	404: "Not Found",
+	405: "HTTP method is not allowed for the requested resource",
+	415: "Unsupported Media Type",
+	429: "Too Many Requests",
+	502: "Bad gateway",
+}
+
+// APIError holds JIRA error response
+// Messages and FieldErrors are decoded from errorMessages and errors of response body,
+// Body holds raw response body
+type APIError struct {
+	Method      string
+	URL         string
+	StatusCode  int
+	Messages    []string
+	FieldErrors map[string]string
+	Body        string
+}
+
+func newAPIError(method, url string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     method,
+		URL:        url,
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+
+	var result struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	if json.Unmarshal(body, &result) == nil {
+		apiErr.Messages = result.ErrorMessages
+		apiErr.FieldErrors = result.Errors
+	}
+
+	return apiErr
+}
+
+// Error returns description of HTTP code and JIRA error messages,
+// raw response body is used for unknown HTTP codes if there are no messages
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("Failed to JIRA request %s %s with HTTP code %d", e.Method, e.URL, e.StatusCode)
+	description, known := statusDescriptions[e.StatusCode]
+	if known {
+		msg += ": " + description
+	}
+
+	messages := append([]string{}, e.Messages...)
+	fields := make([]string, 0, len(e.FieldErrors))
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("%s: %s", field, e.FieldErrors[field]))
+	}
+
+	switch {
+	case len(messages) > 0:
+		msg += ": " + strings.Join(messages, "; ")
+	case !known && e.Body != "":
+		msg += ": " + e.Body
+	}
+
+	return msg
+}
+
+// RateLimitError returned on 429 Too Many Requests
+// RetryAfter holds duration from Retry-After header to wait before next request
+type RateLimitError struct {
+	*APIError
+	RetryAfter time.Duration
+}
+
+// Unwrap returns underlying APIError
+func (e *RateLimitError) Unwrap() error {
+	return e.APIError
+}
+
+// isIssueNotFound reports if JIRA responded with "Issue Does Not Exist" message
+func isIssueNotFound(e *APIError) bool {
+	for _, message := range e.Messages {
+		if strings.Contains(strings.ToLower(message), "issue does not exist") {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrIssueNotFound matches NotFoundError of issue, e.g. errors.Is(err, ErrIssueNotFound)
+var ErrIssueNotFound = errors.New("issue not found")
+
+// NotFoundError returned on 404 Not Found with JIRA error messages, that means resource doesn't exist
+// unlike 404 without messages returned for wrong URL. Resource is type of resource like "issue", ID is its id or key
+type NotFoundError struct {
+	*APIError
+	Resource string
+	ID       string
+}
+
+// Error returns type and id of resource with JIRA error
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found: %s", e.Resource, e.ID, e.APIError.Error())
+}
+
+// Unwrap returns underlying APIError
+func (e *NotFoundError) Unwrap() error {
+	return e.APIError
+}
+
+// Is reports if target is ErrIssueNotFound for NotFoundError of issue
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrIssueNotFound && e.Resource == "issue"
+}
+
+// notFoundError returns NotFoundError of resource if err is 404 with JIRA error messages, otherwise err is returned
+func notFoundError(err error, resource, id string) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound && len(apiErr.Messages) > 0 {
+		return &NotFoundError{
+			APIError: apiErr,
+			Resource: resource,
+			ID:       id,
+		}
+	}
+	return err
+}
+
+// causeError matches sentinel error like ErrInvalidCredentials and keeps its cause like APIError,
+// so both errors.Is(err, sentinel) and errors.As(err, &apiErr) work
+type causeError struct {
+	sentinel error
+	cause    error
+}
+
+// withCause returns error matching sentinel with cause in chain
+func withCause(sentinel, cause error) error {
+	return &causeError{sentinel: sentinel, cause: cause}
+}
+
+// Error returns sentinel message followed by cause
+func (e *causeError) Error() string {
+	return e.sentinel.Error() + ": " + e.cause.Error()
+}
+
+// Unwrap returns cause
+func (e *causeError) Unwrap() error {
+	return e.cause
+}
+
+// Is reports if target is sentinel
+func (e *causeError) Is(target error) bool {
+	return target == e.sentinel
+}
+
+// keyedErrorsMessage returns message of errors of bulk operation, errs[i] is error of item with keys[i],
+// noun names failed items like "issues"
+func keyedErrorsMessage(noun string, keys []string, errs []error) string {
+	messages := make([]string, 0, len(keys))
+	for i, key := range keys {
+		messages = append(messages, fmt.Sprintf("%s: %s", key, errs[i]))
+	}
+
+	return fmt.Sprintf("failed %d %s: %s", len(keys), noun, strings.Join(messages, "; "))
+}
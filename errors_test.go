@@ -0,0 +1,43 @@
+package jirardeau
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNotFoundErrorMessage(t *testing.T) {
+	apiErr := newAPIError("GET", "http://jira/rest/api/2/issue/TEST-1", http.StatusNotFound,
+		[]byte(`{"errorMessages":["Issue Does Not Exist"],"errors":{}}`))
+	err := notFoundError(apiErr, "issue", "TEST-1")
+
+	want := "issue TEST-1 not found: Failed to JIRA request GET http://jira/rest/api/2/issue/TEST-1 with HTTP code 404: Not Found: Issue Does Not Exist"
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+	if strings.Contains(err.Error(), "Wrong request") {
+		t.Errorf("misleading description in %q", err.Error())
+	}
+}
+
+func TestKeyedErrors(t *testing.T) {
+	issuesErr := IssuesError{
+		"TEST-2": errors.New("second"),
+		"TEST-1": errors.New("first"),
+	}
+	want := "failed 2 issues: TEST-1: first; TEST-2: second"
+	if issuesErr.Error() != want {
+		t.Errorf("got %q, want %q", issuesErr.Error(), want)
+	}
+
+	commentsErr := CommentsError{
+		10: errors.New("tenth"),
+		2:  errors.New("second"),
+	}
+	want = "failed 2 comments: 2: second; 10: tenth"
+	if commentsErr.Error() != want {
+		t.Errorf("got %q, want %q", commentsErr.Error(), want)
+	}
+}
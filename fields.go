@@ -0,0 +1,122 @@
+package jirardeau
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Field describes system or custom field of JIRA
+// ID is like "summary" for system fields and "customfield_10001" for custom fields
+type Field struct {
+	ID          string       `json:"id"`
+	Key         string       `json:"key"`
+	Name        string       `json:"name"`
+	Custom      bool         `json:"custom"`
+	Orderable   bool         `json:"orderable"`
+	Navigable   bool         `json:"navigable"`
+	Searchable  bool         `json:"searchable"`
+	ClauseNames []string     `json:"clauseNames"`
+	Schema      *FieldSchema `json:"schema,omitempty"`
+}
+
+// GetFields returns system and custom fields of JIRA
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/field-getFields
+func (jira *Jira) GetFields() (fields []Field, err error) {
+	resp, err := jira.request("GET", "/field", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get fields")
+	}
+
+	err = json.NewDecoder(resp).Decode(&fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get fields, failed to decode response")
+	}
+
+	return fields, nil
+}
+
+// CustomFieldID returns id like "customfield_10001" of custom field with given name like "Story Points"
+// Fields are fetched once and cached for lifetime of Jira
+func (jira *Jira) CustomFieldID(name string) (id string, err error) {
+	fields, err := jira.cachedFields()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed get custom field %q", name)
+	}
+
+	for _, field := range fields {
+		if !field.Custom || field.Name != name {
+			continue
+		}
+		if id != "" {
+			return "", errors.Errorf("failed get custom field %q: name is ambiguous, found %s and %s", name, id, field.ID)
+		}
+		id = field.ID
+	}
+	if id == "" {
+		return "", errors.Errorf("failed get custom field %q: not found", name)
+	}
+
+	return id, nil
+}
+
+// cachedFields returns cached fields, fields are fetched if cache is empty
+func (jira *Jira) cachedFields() (fields []Field, err error) {
+	jira.mu.Lock()
+	fields = jira.fieldsCache
+	jira.mu.Unlock()
+	if fields != nil {
+		return fields, nil
+	}
+
+	fields, err = jira.GetFields()
+	if err != nil {
+		return nil, err
+	}
+
+	jira.mu.Lock()
+	jira.fieldsCache = fields
+	jira.mu.Unlock()
+
+	return fields, nil
+}
+
+// SetCustomFieldByName sets custom field by name like "Story Points", name is resolved to id
+// by CreateIssue, UpdateIssue and DoTransition using CustomFieldID
+func (fields *ModifyIssueFields) SetCustomFieldByName(name string, value CustomFieldValue) {
+	if fields.CustomFieldsByName == nil {
+		fields.CustomFieldsByName = make(map[string]CustomFieldValue)
+	}
+	fields.CustomFieldsByName[name] = value
+}
+
+// requestFields returns copy of fields prepared for request: users are referenced according to Deployment,
+// description and environment are converted to ADF if required and custom fields names are resolved to ids
+func (jira *Jira) requestFields(fields ModifyIssueFields) (ModifyIssueFields, error) {
+	fields = jira.deploymentFields(fields)
+	if fields.DescriptionADF == nil && fields.Description != "" && jira.useADF() {
+		fields.DescriptionADF = NewADFDocument(fields.Description)
+	}
+	if fields.EnvironmentADF == nil && fields.Environment != "" && jira.useADF() {
+		fields.EnvironmentADF = NewADFDocument(fields.Environment)
+	}
+	if len(fields.CustomFieldsByName) == 0 {
+		return fields, nil
+	}
+
+	values := make(map[string]CustomFieldValue, len(fields.CustomFieldValues)+len(fields.CustomFieldsByName))
+	for id, value := range fields.CustomFieldValues {
+		values[id] = value
+	}
+	for name, value := range fields.CustomFieldsByName {
+		id, err := jira.CustomFieldID(name)
+		if err != nil {
+			return fields, err
+		}
+		values[id] = value
+	}
+	fields.CustomFieldValues = values
+	fields.CustomFieldsByName = nil
+
+	return fields, nil
+}
@@ -0,0 +1,40 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GetIssueTypes returns issue types available on JIRA
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issuetype-getIssueAllTypes
+func (jira *Jira) GetIssueTypes() (issueTypes []IssueType, err error) {
+	resp, err := jira.request("GET", "/issuetype", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get issue types")
+	}
+
+	err = json.NewDecoder(resp).Decode(&issueTypes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get issue types, failed to decode response")
+	}
+
+	return issueTypes, nil
+}
+
+// GetIssueTypeByName returns issue type with given name, name is compared case-insensitively
+func (jira *Jira) GetIssueTypeByName(name string) (issueType IssueType, err error) {
+	issueTypes, err := jira.GetIssueTypes()
+	if err != nil {
+		return issueType, err
+	}
+
+	for _, issueType := range issueTypes {
+		if strings.EqualFold(issueType.Name, name) {
+			return issueType, nil
+		}
+	}
+
+	return issueType, errors.Errorf("failed get issue type %q: not found", name)
+}
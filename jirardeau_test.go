@@ -0,0 +1,411 @@
+package jirardeau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestNoContent(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	jira := &Jira{URL: server.URL, Log: log.New(ioutil.Discard, "", 0)}
+
+	err := jira.UpdateIssue(RequestUpdateIssue{Key: "TEST-1", Fields: ModifyIssueFields{Summary: "s"}})
+	if err != nil {
+		t.Errorf("UpdateIssue: %v", err)
+	}
+	err = jira.DeleteComment("TEST-1", "10000")
+	if err != nil {
+		t.Errorf("DeleteComment: %v", err)
+	}
+	body, err := jira.request("PUT", "/issue/TEST-1", nil)
+	if err != nil {
+		t.Errorf("request: %v", err)
+	}
+	if body != http.NoBody {
+		t.Errorf("request returned %v, want http.NoBody", body)
+	}
+	if len(methods) != 3 {
+		t.Errorf("server received %d requests, want 3", len(methods))
+	}
+}
+
+func TestGetIssuesFixVersionJQL(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: `2.0 "RC"`, want: `project = TEST AND fixVersion = "2.0 \"RC\""`},
+		{name: `2.0\beta`, want: `project = TEST AND fixVersion = "2.0\\beta"`},
+		{name: ` 2.0 `, want: `project = TEST AND fixVersion = " 2.0 "`},
+	}
+
+	var jql string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jql = r.URL.Query().Get("jql")
+		w.Write([]byte(`{"startAt":0,"maxResults":50,"total":0,"issues":[]}`))
+	}))
+	defer server.Close()
+
+	jira := &Jira{URL: server.URL, Project: "TEST", Log: log.New(ioutil.Discard, "", 0)}
+
+	for _, test := range tests {
+		_, err := jira.GetIssues(FixVersion{Name: test.name})
+		if err != nil {
+			t.Errorf("%q: %v", test.name, err)
+			continue
+		}
+		if jql != test.want {
+			t.Errorf("%q: got jql %s, want %s", test.name, jql, test.want)
+		}
+	}
+}
+
+func TestModifyIssueFieldsMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields ModifyIssueFields
+		want   string
+	}{
+		{
+			name:   "custom fields only",
+			fields: ModifyIssueFields{CustomFields: CustomField{"customfield_10001": "a{}"}},
+			want:   `{"customfield_10001":{"value":"a{}"}}`,
+		},
+		{
+			name:   "standard fields only",
+			fields: ModifyIssueFields{Summary: "s}{", Labels: []string{"a"}},
+			want:   `{"labels":["a"],"summary":"s}{"}`,
+		},
+		{
+			name: "standard and custom fields",
+			fields: ModifyIssueFields{
+				Summary:           "s",
+				CustomFields:      CustomField{"customfield_10001": "a"},
+				CustomFieldValues: map[string]CustomFieldValue{"customfield_10002": {Values: []string{"b", "c"}}},
+			},
+			want: `{"customfield_10001":{"value":"a"},"customfield_10002":[{"value":"b"},{"value":"c"}],"summary":"s"}`,
+		},
+		{
+			name: "no fields",
+			want: `{}`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := json.Marshal(test.fields)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	jira, err := NewJira(server.URL, WithTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	_, err = jira.GetIssue("TEST-1", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("request timed out in %s", elapsed)
+	}
+}
+
+func TestModifyIssueFieldsMarshalJSONStdout(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	fields := ModifyIssueFields{
+		Summary:      "s",
+		CustomFields: CustomField{"customfield_10001": "x"},
+	}
+	_, err = json.Marshal(fields)
+	os.Stdout = stdout
+	writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(output) > 0 {
+		t.Errorf("MarshalJSON wrote to stdout: %q", output)
+	}
+}
+
+func TestZeroValueJira(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	jira := &Jira{URL: server.URL}
+	issue, err := jira.GetIssue("TEST-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("unexpected issue key %q", issue.Key)
+	}
+}
+
+func TestCreateIssueCustomFieldOnly(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue, err := jira.CreateIssue(RequestCreateIssue{Fields: ModifyIssueFields{
+		CustomFields: CustomField{"customfield_10001": "a"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("unexpected issue key %q", issue.Key)
+	}
+	want := `{"fields":{"customfield_10001":{"value":"a"}}}` + "\n"
+	if string(body) != want {
+		t.Errorf("got body %s, want %s", body, want)
+	}
+}
+
+func TestStatusUnmarshalJSON(t *testing.T) {
+	payloads := []string{
+		`{"status":{"id":"1","name":"Open"}}`,
+		`{"status":"Open"}`,
+	}
+
+	for _, payload := range payloads {
+		var fields IssueFields
+		err := json.Unmarshal([]byte(payload), &fields)
+		if err != nil {
+			t.Errorf("%s: %v", payload, err)
+			continue
+		}
+		if fields.Status.Name != "Open" {
+			t.Errorf("%s: got status %q, want Open", payload, fields.Status.Name)
+		}
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == DefaultAPIBasePath+"/search" {
+			w.Write([]byte(`{"startAt":0,"maxResults":50,"total":0,"issues":[]}`))
+			return
+		}
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithProject("TEST"), WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue, err := jira.CreateIssueAndFetch(RequestCreateIssue{Fields: ModifyIssueFields{Summary: "s"}}, nil)
+	if err != nil {
+		t.Fatalf("CreateIssueAndFetch: %v", err)
+	}
+	if issue.Key != DryRunIssueKey || issue.Fields == nil || issue.Fields.Summary != "s" {
+		t.Errorf("unexpected dry run issue %+v", issue)
+	}
+	if err = jira.UpdateIssue(RequestUpdateIssue{Key: "TEST-1", Fields: ModifyIssueFields{Summary: "s"}}); err != nil {
+		t.Errorf("UpdateIssue: %v", err)
+	}
+	comment, err := jira.AddComment("TEST-1", "c")
+	if err != nil || comment.ID != "0" || comment.Body != "c" {
+		t.Errorf("AddComment: unexpected comment %+v: %v", comment, err)
+	}
+	attachment, err := jira.AddAttachment("TEST-1", "a.txt", strings.NewReader("a"))
+	if err != nil || attachment.ID != "0" {
+		t.Errorf("AddAttachment: unexpected attachment %+v: %v", attachment, err)
+	}
+	version, err := jira.CreateFixVersion(FixVersion{Name: "1.0"})
+	if err != nil || version.ID != "0" || version.Name != "1.0" {
+		t.Errorf("CreateFixVersion: unexpected version %+v: %v", version, err)
+	}
+	if err = jira.DeleteComment("TEST-1", "10000"); err != nil {
+		t.Errorf("DeleteComment: %v", err)
+	}
+
+	if _, err = jira.GetIssue("TEST-1", nil); err != nil {
+		t.Errorf("GetIssue: %v", err)
+	}
+	if _, err = jira.SearchJQL("project = TEST", nil, nil); err != nil {
+		t.Errorf("SearchJQL: %v", err)
+	}
+
+	want := []string{
+		"GET " + DefaultAPIBasePath + "/issue/TEST-1",
+		"POST " + DefaultAPIBasePath + "/search",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("server received %v, want %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("server received %v, want %v", requests, want)
+			break
+		}
+	}
+}
+
+// pagedSearchServer serves count issues of total in pages of pageSize regardless of requested maxResults
+func pagedSearchServer(count, total, pageSize int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		issues := make([]string, 0, pageSize)
+		for i := startAt; i < count && i < startAt+pageSize; i++ {
+			issues = append(issues, fmt.Sprintf(`{"key":"TEST-%d"}`, i+1))
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":%d,"total":%d,"issues":[%s]}`,
+			startAt, pageSize, total, strings.Join(issues, ","))
+	}))
+}
+
+func TestGetIssuesPages(t *testing.T) {
+	tests := []struct {
+		name                   string
+		count, total, pageSize int
+	}{
+		{name: "no issues", count: 0, total: 0, pageSize: 3},
+		{name: "single page", count: 2, total: 2, pageSize: 3},
+		{name: "short last page", count: 7, total: 7, pageSize: 3},
+		{name: "exact pages", count: 6, total: 6, pageSize: 3},
+		{name: "empty page before total", count: 4, total: 10, pageSize: 2},
+	}
+
+	for _, test := range tests {
+		server := pagedSearchServer(test.count, test.total, test.pageSize)
+
+		jira, err := NewJira(server.URL, WithProject("TEST"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		list, err := jira.GetIssuesList(FixVersion{Name: "1.0"})
+		if err != nil {
+			t.Errorf("%s: GetIssuesList: %v", test.name, err)
+		}
+		issues, err := jira.GetIssues(FixVersion{Name: "1.0"})
+		if err != nil {
+			t.Errorf("%s: GetIssues: %v", test.name, err)
+		}
+		server.Close()
+
+		if len(list) != test.count || len(issues) != test.count {
+			t.Errorf("%s: got %d and %d issues, want %d", test.name, len(list), len(issues), test.count)
+			continue
+		}
+		for i, issue := range list {
+			if key := fmt.Sprintf("TEST-%d", i+1); issue.Key != key || issues[key].Key != key {
+				t.Errorf("%s: issue %d is %s, want %s", test.name, i, issue.Key, key)
+			}
+		}
+	}
+}
+
+func TestGetIssuesPaged(t *testing.T) {
+	server := pagedSearchServer(7, 7, 3)
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithProject("TEST"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues, total, err := jira.GetIssuesPaged(FixVersion{Name: "1.0"}, 6, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 7 || len(issues) != 1 || issues[0].Key != "TEST-7" {
+		t.Errorf("unexpected last page %v of %d issues", issues, total)
+	}
+}
+
+func TestGetIssueIfModified(t *testing.T) {
+	const etag = `"10000-1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"id":"10000","key":"TEST-1","fields":{"summary":"s"}}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue, newETag, err := jira.GetIssueIfModified("TEST-1", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Key != "TEST-1" || newETag != etag {
+		t.Errorf("unexpected issue %q with ETag %q", issue.Key, newETag)
+	}
+
+	issue, newETag, err = jira.GetIssueIfModified("TEST-1", newETag, nil)
+	if err != ErrNotModified {
+		t.Errorf("expected ErrNotModified, got %v", err)
+	}
+	if issue.Key != "" || newETag != etag {
+		t.Errorf("unexpected not modified issue %q with ETag %q", issue.Key, newETag)
+	}
+}
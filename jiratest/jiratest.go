@@ -0,0 +1,218 @@
+// Package jiratest provides fake JIRA server for testing code which uses jirardeau.
+//
+// Server serves canned issues recorded from real JIRA responses:
+//
+//	server := jiratest.NewServer()
+//	defer server.Close()
+//	server.AddIssue("TEST-1", recordedIssueJSON)
+//	jira := server.Jira()
+//	issue, err := jira.GetIssue("TEST-1", nil)
+//
+// Any httptest.Server can be used the same way by setting Jira.URL and Jira.HTTPClient.
+package jiratest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/oneumyvakin/jirardeau"
+)
+
+// APIPath is path of JIRA REST API served by Server
+const APIPath = "/rest/api/2"
+
+// Project is key of project used by Server for created issues by default
+const Project = "TEST"
+
+// Server is fake JIRA serving canned issues for GetIssue, GetIssues, SearchJQL and CreateIssue
+// Search returns all added issues regardless of JQL
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	keys   []string
+	issues map[string]json.RawMessage
+	nextID int
+	// numbers holds last number of issue key created per project
+	numbers map[string]int
+}
+
+// NewServer starts fake JIRA server, caller should Close it
+func NewServer() *Server {
+	server := &Server{
+		issues:  make(map[string]json.RawMessage),
+		nextID:  10000,
+		numbers: make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(APIPath+"/issue", server.handleCreateIssue)
+	mux.HandleFunc(APIPath+"/issue/", server.handleGetIssue)
+	mux.HandleFunc(APIPath+"/search", server.handleSearch)
+	server.Server = httptest.NewServer(mux)
+
+	return server
+}
+
+// Jira returns client configured to use Server
+func (server *Server) Jira() *jirardeau.Jira {
+	return &jirardeau.Jira{
+		Log:        log.New(ioutil.Discard, "", 0),
+		Project:    Project,
+		URL:        server.URL,
+		HTTPClient: server.Client(),
+	}
+}
+
+// AddIssue adds issue served by key, payload is issue JSON as returned by GET /issue/{key}
+func (server *Server) AddIssue(key string, payload []byte) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.addIssue(key, payload)
+}
+
+// addIssue adds issue served by key, caller must hold mu
+func (server *Server) addIssue(key string, payload []byte) {
+	if _, ok := server.issues[key]; !ok {
+		server.keys = append(server.keys, key)
+	}
+	server.issues[key] = json.RawMessage(payload)
+}
+
+func (server *Server) handleGetIssue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, APIPath+"/issue/")
+
+	server.mu.Lock()
+	payload, ok := server.issues[key]
+	server.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "Issue Does Not Exist")
+		return
+	}
+
+	w.Header().Set("content-type", "application/json")
+	w.Write(payload)
+}
+
+func (server *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	var startAt, maxResults int
+	switch r.Method {
+	case "GET":
+		startAt, _ = strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ = strconv.Atoi(r.URL.Query().Get("maxResults"))
+	case "POST":
+		var request struct {
+			StartAt    int `json:"startAt"`
+			MaxResults int `json:"maxResults"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		startAt, maxResults = request.StartAt, request.MaxResults
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if maxResults <= 0 {
+		maxResults = 50
+	}
+
+	server.mu.Lock()
+	issues := make([]json.RawMessage, 0, maxResults)
+	for i := startAt; i < len(server.keys) && i < startAt+maxResults; i++ {
+		issues = append(issues, server.issues[server.keys[i]])
+	}
+	total := len(server.keys)
+	server.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"startAt":    startAt,
+		"maxResults": maxResults,
+		"total":      total,
+		"issues":     issues,
+	})
+}
+
+func (server *Server) handleCreateIssue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var request struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	project := Project
+	var projectField struct {
+		Key string `json:"key"`
+	}
+	if json.Unmarshal(request.Fields["project"], &projectField) == nil && projectField.Key != "" {
+		project = projectField.Key
+	}
+
+	// key is allocated and issue is added under the same lock, so concurrent creates get different keys
+	server.mu.Lock()
+	server.nextID++
+	id := strconv.Itoa(server.nextID)
+	var key string
+	for {
+		server.numbers[project]++
+		key = fmt.Sprintf("%s-%d", project, server.numbers[project])
+		// keys of issues added by AddIssue are skipped
+		if _, ok := server.issues[key]; !ok {
+			break
+		}
+	}
+
+	created := map[string]interface{}{
+		"id":   id,
+		"key":  key,
+		"self": fmt.Sprintf("%s%s/issue/%s", server.URL, APIPath, id),
+	}
+
+	var payload bytes.Buffer
+	json.NewEncoder(&payload).Encode(map[string]interface{}{
+		"id":     id,
+		"key":    key,
+		"self":   created["self"],
+		"fields": request.Fields,
+	})
+	server.addIssue(key, payload.Bytes())
+	server.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"errorMessages": []string{message},
+		"errors":        map[string]string{},
+	})
+}
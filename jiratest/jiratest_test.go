@@ -0,0 +1,83 @@
+package jiratest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/oneumyvakin/jirardeau"
+	"github.com/pkg/errors"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddIssue("TEST-1", []byte(`{"id":"1","key":"TEST-1","fields":{"summary":"first"}}`))
+	server.AddIssue("TEST-2", []byte(`{"id":"2","key":"TEST-2","fields":{"summary":"second"}}`))
+
+	jira := server.Jira()
+
+	issue, err := jira.GetIssue("TEST-2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Fields.Summary != "second" {
+		t.Errorf("unexpected summary %q", issue.Fields.Summary)
+	}
+
+	_, err = jira.GetIssue("TEST-404", nil)
+	if !errors.Is(err, jirardeau.ErrIssueNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	created, err := jira.CreateIssue(jirardeau.RequestCreateIssue{Fields: jirardeau.ModifyIssueFields{Summary: "third"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Key != "TEST-3" {
+		t.Errorf("created issue %s overrides added issue", created.Key)
+	}
+
+	issues, err := jira.SearchJQL("project = TEST", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 3 {
+		t.Errorf("search returned %d issues, want 3", len(issues))
+	}
+}
+
+func TestServerConcurrentCreate(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	jira := server.Jira()
+
+	const creates = 20
+	keys := make(chan string, creates)
+	var wg sync.WaitGroup
+	for i := 0; i < creates; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			issue, err := jira.CreateIssue(jirardeau.RequestCreateIssue{Fields: jirardeau.ModifyIssueFields{Summary: "s"}})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			keys <- issue.Key
+		}()
+	}
+	wg.Wait()
+	close(keys)
+
+	unique := make(map[string]bool)
+	for key := range keys {
+		if unique[key] {
+			t.Errorf("key %s is created twice", key)
+		}
+		unique[key] = true
+	}
+	if len(unique) != creates {
+		t.Errorf("created %d issues, want %d", len(unique), creates)
+	}
+}
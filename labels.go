@@ -0,0 +1,80 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AddLabels adds labels to issue keeping existed ones
+func (jira *Jira) AddLabels(issueKey string, labels ...string) error {
+	err := jira.updateLabels(issueKey, "add", labels)
+	if err != nil {
+		return errors.Wrap(err, "failed add labels")
+	}
+
+	return nil
+}
+
+// RemoveLabels removes labels from issue keeping other ones
+func (jira *Jira) RemoveLabels(issueKey string, labels ...string) error {
+	err := jira.updateLabels(issueKey, "remove", labels)
+	if err != nil {
+		return errors.Wrap(err, "failed remove labels")
+	}
+
+	return nil
+}
+
+func (jira *Jira) updateLabels(issueKey, operation string, labels []string) error {
+	operations := make([]map[string]interface{}, 0, len(labels))
+	for _, label := range labels {
+		if err := validateLabel(label); err != nil {
+			return err
+		}
+		operations = append(operations, map[string]interface{}{operation: label})
+	}
+
+	return jira.updateIssueOperations(issueKey, map[string][]map[string]interface{}{
+		"labels": operations,
+	})
+}
+
+// validateLabel checks label as JIRA rejects labels with spaces
+func validateLabel(label string) error {
+	if label == "" {
+		return errors.New("label is empty")
+	}
+	if strings.ContainsAny(label, " \t\r\n") {
+		return errors.Errorf("label %q contains spaces", label)
+	}
+
+	return nil
+}
+
+// updateIssueOperations applies "update" section operations like add/remove/set to issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-editIssue
+func (jira *Jira) updateIssueOperations(issueKey string, update map[string][]map[string]interface{}) error {
+	if issueKey == "" {
+		return errors.New("issue Key is empty")
+	}
+
+	request := struct {
+		Update map[string][]map[string]interface{} `json:"update"`
+	}{
+		Update: update,
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(request)
+	if err != nil {
+		return err
+	}
+
+	_, err = jira.request("PUT", fmt.Sprintf("/issue/%s", issueKey), &buf)
+
+	return err
+}
@@ -0,0 +1,95 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// IssueLink holds link between issues
+// Only one of InwardIssue and OutwardIssue is set when link is read from issue fields
+type IssueLink struct {
+	ID           string        `json:"id"`
+	Self         string        `json:"self"`
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *Issue        `json:"inwardIssue,omitempty"`
+	OutwardIssue *Issue        `json:"outwardIssue,omitempty"`
+}
+
+// IssueLinkType describes link type like "Blocks" with its inward "is blocked by"
+// and outward "blocks" descriptions
+type IssueLinkType struct {
+	ID      string `json:"id"`
+	Self    string `json:"self"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
+
+// requestIssueLink holds body of POST /issueLink
+type requestIssueLink struct {
+	Type struct {
+		Name string `json:"name"`
+	} `json:"type"`
+	InwardIssue struct {
+		Key string `json:"key"`
+	} `json:"inwardIssue"`
+	OutwardIssue struct {
+		Key string `json:"key"`
+	} `json:"outwardIssue"`
+	Comment *requestComment `json:"comment,omitempty"`
+}
+
+// CreateIssueLink links issues by link type name, e.g. "Blocks" means outwardKey blocks inwardKey
+// comment is added to outward issue if not empty, it is sent as ADF document on Cloud with APIv3BasePath
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issueLink-linkIssues
+func (jira *Jira) CreateIssueLink(linkType, inwardKey, outwardKey, comment string) error {
+	if linkType == "" {
+		return errors.New("failed create issue link: link type is empty")
+	}
+	if inwardKey == "" || outwardKey == "" {
+		return errors.New("failed create issue link: issue Key is empty")
+	}
+
+	request := requestIssueLink{}
+	request.Type.Name = linkType
+	request.InwardIssue.Key = inwardKey
+	request.OutwardIssue.Key = outwardKey
+	if comment != "" {
+		request.Comment = &requestComment{Body: jira.richText(comment, nil)}
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(request)
+	if err != nil {
+		return errors.Wrap(err, "failed create issue link")
+	}
+
+	_, err = jira.request("POST", "/issueLink", &buf)
+	if err != nil {
+		return errors.Wrap(err, "failed create issue link")
+	}
+
+	return nil
+}
+
+// GetIssueLinkTypes returns link types available on JIRA
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issueLinkType-getIssueLinkTypes
+func (jira *Jira) GetIssueLinkTypes() (linkTypes []IssueLinkType, err error) {
+	var result struct {
+		IssueLinkTypes []IssueLinkType `json:"issueLinkTypes"`
+	}
+
+	resp, err := jira.request("GET", "/issueLinkType", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get issue link types")
+	}
+
+	err = json.NewDecoder(resp).Decode(&result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get issue link types, failed to decode response")
+	}
+
+	return result.IssueLinkTypes, nil
+}
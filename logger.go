@@ -0,0 +1,97 @@
+package jirardeau
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Logger is leveled logger
+// Debug messages contain request and response details like headers and bodies
+type Logger interface {
+	Debug(v ...interface{})
+	Info(v ...interface{})
+	Error(v ...interface{})
+}
+
+// discardLogger is used when Jira.Log is nil
+var discardLogger = log.New(ioutil.Discard, "", 0)
+
+// stdLogger adapts *log.Logger to Logger
+type stdLogger struct {
+	log   *log.Logger
+	debug bool
+}
+
+func (logger stdLogger) Debug(v ...interface{}) {
+	if logger.debug {
+		logger.log.Println(v...)
+	}
+}
+
+func (logger stdLogger) Info(v ...interface{}) {
+	logger.log.Println(v...)
+}
+
+func (logger stdLogger) Error(v ...interface{}) {
+	logger.log.Println(v...)
+}
+
+// logger returns Logger, Log or discarding logger if both are nil
+func (jira *Jira) logger() Logger {
+	if jira.Logger != nil {
+		return jira.Logger
+	}
+	if jira.Log != nil {
+		return stdLogger{log: jira.Log, debug: jira.Debug}
+	}
+	return stdLogger{log: discardLogger}
+}
+
+// sensitiveHeaders holds canonical names of headers which values are not logged
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Atlassian-Token",
+}
+
+// redactHeader returns copy of header with sensitive values replaced,
+// it should be used for every header written to log
+func redactHeader(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for key, values := range header {
+		redacted[key] = values
+	}
+	for _, key := range sensitiveHeaders {
+		if _, ok := redacted[key]; ok {
+			redacted[key] = []string{"REDACTED"}
+		}
+	}
+
+	return redacted
+}
+
+// sensitiveBodyRegexp matches values of JSON keys which are not logged
+var sensitiveBodyRegexp = regexp.MustCompile(`("(?i:password|token|accessToken|refreshToken|secret)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// maxLoggedBody limits size of request and response body written to log
+const maxLoggedBody = 64 << 10
+
+// redactBody returns JSON body with sensitive values replaced and truncated to maxLoggedBody,
+// it should be used for every body written to log
+func redactBody(body []byte, contentType string) string {
+	if contentType != "" && !strings.Contains(contentType, "json") {
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	}
+
+	redacted := sensitiveBodyRegexp.ReplaceAllString(string(body), `$1"REDACTED"`)
+	if len(redacted) > maxLoggedBody {
+		redacted = redacted[:maxLoggedBody] + "...(truncated)"
+	}
+
+	return redacted
+}
@@ -0,0 +1,46 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/base64"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDebugLogRedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Authorization", r.Header.Get("Authorization"))
+		w.Header().Set("Set-Cookie", "JSESSIONID=session-secret")
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	jira, err := NewJira(server.URL, WithBasicAuth("jdoe", "password-secret"), WithLogger(log.New(&output, "", 0)), WithDebug())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = jira.GetIssue("TEST-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logged := output.String()
+	if !strings.Contains(logged, "REDACTED") {
+		t.Fatalf("headers are not logged with Debug: %s", logged)
+	}
+	secrets := []string{
+		base64.StdEncoding.EncodeToString([]byte("jdoe:password-secret")),
+		"password-secret",
+		"session-secret",
+	}
+	for _, secret := range secrets {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains %q: %s", secret, logged)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package jirardeau
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// requestBulkMove holds body of POST /bulk/issues/move
+type requestBulkMove struct {
+	SendBulkNotification   bool                       `json:"sendBulkNotification"`
+	TargetToSourcesMapping map[string]bulkMoveMapping `json:"targetToSourcesMapping"`
+}
+
+// bulkMoveMapping holds issues moved to project and issue type with values of mandatory fields
+type bulkMoveMapping struct {
+	InferClassificationDefaults bool                 `json:"inferClassificationDefaults"`
+	InferFieldDefaults          bool                 `json:"inferFieldDefaults"`
+	InferStatusDefaults         bool                 `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault     bool                 `json:"inferSubtaskTypeDefault"`
+	IssueIdsOrKeys              []string             `json:"issueIdsOrKeys"`
+	TargetMandatoryFields       []bulkMoveFieldValue `json:"targetMandatoryFields,omitempty"`
+}
+
+// bulkMoveFieldValue holds values of mandatory fields by field id
+type bulkMoveFieldValue struct {
+	Fields map[string]bulkMoveField `json:"fields"`
+}
+
+type bulkMoveField struct {
+	Retain bool     `json:"retain"`
+	Type   string   `json:"type"`
+	Value  []string `json:"value"`
+}
+
+// MoveIssue moves issue to project and issue type, see MoveIssueContext
+func (jira *Jira) MoveIssue(issueKey, targetProjectKey, targetIssueTypeID string, fieldMappings map[string]string) error {
+	return jira.MoveIssueContext(context.Background(), issueKey, targetProjectKey, targetIssueTypeID, fieldMappings)
+}
+
+// MoveIssueContext moves issue to project and issue type on Jira Cloud, JIRA Server has no REST API to move issues.
+// fieldMappings holds values of fields mandatory in target project by field id, nil uses defaults of target project.
+// Move is queued by JIRA and completes asynchronously after MoveIssue returns
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-move-post
+func (jira *Jira) MoveIssueContext(ctx context.Context, issueKey, targetProjectKey, targetIssueTypeID string, fieldMappings map[string]string) error {
+	if !jira.isCloud() {
+		return errors.New("failed move issue: move is supported on Jira Cloud only")
+	}
+	if targetProjectKey == "" || targetIssueTypeID == "" {
+		return errors.New("failed move issue: target project or issue type is empty")
+	}
+
+	mapping := bulkMoveMapping{
+		InferClassificationDefaults: true,
+		InferFieldDefaults:          true,
+		InferStatusDefaults:         true,
+		InferSubtaskTypeDefault:     true,
+		IssueIdsOrKeys:              []string{issueKey},
+	}
+	if len(fieldMappings) > 0 {
+		fields := make(map[string]bulkMoveField, len(fieldMappings))
+		for id, value := range fieldMappings {
+			fields[id] = bulkMoveField{Type: "raw", Value: []string{value}}
+		}
+		mapping.TargetMandatoryFields = []bulkMoveFieldValue{{Fields: fields}}
+	}
+
+	request := requestBulkMove{
+		SendBulkNotification: true,
+		TargetToSourcesMapping: map[string]bulkMoveMapping{
+			targetProjectKey + "," + targetIssueTypeID: mapping,
+		},
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(request)
+	if err != nil {
+		return errors.Wrap(err, "failed move issue")
+	}
+
+	_, err = jira.requestAPI(ctx, APIv3BasePath, "POST", "/bulk/issues/move", &buf, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed move issue")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package jirardeau
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMoveIssue(t *testing.T) {
+	var path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		path, body = r.URL.Path, string(data)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"taskId":"10000"}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithDeployment(DeploymentCloud))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = jira.MoveIssue("TEST-1", "DEST", "10001", map[string]string{"customfield_10002": "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != APIv3BasePath+"/bulk/issues/move" {
+		t.Errorf("unexpected path %s", path)
+	}
+	want := `{"sendBulkNotification":true,"targetToSourcesMapping":{"DEST,10001":{` +
+		`"inferClassificationDefaults":true,"inferFieldDefaults":true,"inferStatusDefaults":true,"inferSubtaskTypeDefault":true,` +
+		`"issueIdsOrKeys":["TEST-1"],` +
+		`"targetMandatoryFields":[{"fields":{"customfield_10002":{"retain":false,"type":"raw","value":["x"]}}}]}}}` + "\n"
+	if body != want {
+		t.Errorf("got body\n%s\nwant\n%s", body, want)
+	}
+
+	body = ""
+	err = jira.MoveIssue("TEST-1", "DEST", "10001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `{"sendBulkNotification":true,"targetToSourcesMapping":{"DEST,10001":{` +
+		`"inferClassificationDefaults":true,"inferFieldDefaults":true,"inferStatusDefaults":true,"inferSubtaskTypeDefault":true,` +
+		`"issueIdsOrKeys":["TEST-1"]}}}` + "\n"
+	if body != want {
+		t.Errorf("got body\n%s\nwant\n%s", body, want)
+	}
+}
+
+func TestMoveIssueServer(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithDeployment(DeploymentServer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = jira.MoveIssue("TEST-1", "DEST", "10001", nil)
+	if err == nil {
+		t.Error("expected error of move on JIRA Server")
+	}
+	if requests != 0 {
+		t.Errorf("server received %d requests, want 0", requests)
+	}
+}
@@ -0,0 +1,53 @@
+package jirardeau
+
+import (
+	"github.com/pkg/errors"
+)
+
+// TokenSource returns OAuth 2.0 access token sent as "Authorization: Bearer" header
+// Token is called for every request with refresh false and should return cached token refreshed when expired,
+// it's called with refresh true once JIRA rejects token with 401 to get new token with refresh token.
+// TokenSource must be safe for concurrent use.
+// TokenSource of golang.org/x/oauth2 has no way to force refresh, so it's adapted by creating new source
+// with refresh token only, source is guarded by mutex since Token is called concurrently:
+//
+//	var mu sync.Mutex
+//	token := initialToken
+//	source := config.TokenSource(ctx, token)
+//	jira.TokenSource = jirardeau.TokenSourceFunc(func(refresh bool) (string, error) {
+//		mu.Lock()
+//		defer mu.Unlock()
+//		if refresh {
+//			source = config.TokenSource(ctx, &oauth2.Token{RefreshToken: token.RefreshToken})
+//		}
+//		newToken, err := source.Token()
+//		if err != nil {
+//			return "", err
+//		}
+//		token = newToken
+//		return token.AccessToken, nil
+//	})
+type TokenSource interface {
+	Token(refresh bool) (accessToken string, err error)
+}
+
+// TokenSourceFunc is function used as TokenSource
+type TokenSourceFunc func(refresh bool) (accessToken string, err error)
+
+// Token returns result of f
+func (f TokenSourceFunc) Token(refresh bool) (accessToken string, err error) {
+	return f(refresh)
+}
+
+// tokenAuthorization returns Authorization header value with access token of TokenSource
+func (jira *Jira) tokenAuthorization(refresh bool) (authorization string, err error) {
+	accessToken, err := jira.TokenSource.Token(refresh)
+	if err != nil {
+		return "", errors.Wrap(err, "failed get OAuth access token")
+	}
+	if accessToken == "" {
+		return "", errors.New("failed get OAuth access token: token is empty")
+	}
+
+	return "Bearer " + accessToken, nil
+}
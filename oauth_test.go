@@ -0,0 +1,47 @@
+package jirardeau
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestTokenSourceRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	accessToken := "expired"
+	source := TokenSourceFunc(func(refresh bool) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if refresh {
+			accessToken = "fresh"
+		}
+		return accessToken, nil
+	})
+
+	jira, err := NewJira(server.URL, WithTokenSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := jira.GetIssue("TEST-1", nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
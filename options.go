@@ -0,0 +1,139 @@
+package jirardeau
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Option configures Jira created by NewJira
+type Option func(jira *Jira)
+
+// NewJira returns Jira for JIRA URL like https://jira.tld
+// Log discards output unless WithLogger is given
+func NewJira(jiraURL string, opts ...Option) (*Jira, error) {
+	if jiraURL == "" {
+		return nil, errors.New("failed create jira: URL is empty")
+	}
+	parsedURL, err := url.Parse(jiraURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed create jira: failed to parse URL")
+	}
+	if parsedURL.Scheme == "" || parsedURL.Host == "" {
+		return nil, errors.Errorf("failed create jira: URL %s must be absolute", jiraURL)
+	}
+
+	jira := &Jira{
+		Log: discardLogger,
+		URL: jiraURL,
+	}
+	for _, opt := range opts {
+		opt(jira)
+	}
+
+	return jira, nil
+}
+
+// WithBasicAuth sets Login and Password
+func WithBasicAuth(login, password string) Option {
+	return func(jira *Jira) {
+		jira.Login = login
+		jira.Password = password
+	}
+}
+
+// WithToken sets AuthToken
+func WithToken(token string) Option {
+	return func(jira *Jira) {
+		jira.AuthToken = token
+	}
+}
+
+// WithHTTPClient sets HTTPClient
+func WithHTTPClient(client *http.Client) Option {
+	return func(jira *Jira) {
+		jira.HTTPClient = client
+	}
+}
+
+// WithLogger sets Log, nil keeps discarding logger
+func WithLogger(logger *log.Logger) Option {
+	return func(jira *Jira) {
+		if logger != nil {
+			jira.Log = logger
+		}
+	}
+}
+
+// WithProject sets Project key
+func WithProject(key string) Option {
+	return func(jira *Jira) {
+		jira.Project = key
+	}
+}
+
+// WithRetryPolicy sets RetryPolicy
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(jira *Jira) {
+		jira.RetryPolicy = policy
+	}
+}
+
+// WithLeveledLogger sets Logger
+func WithLeveledLogger(logger Logger) Option {
+	return func(jira *Jira) {
+		jira.Logger = logger
+	}
+}
+
+// WithDebug enables Debug messages of Log
+func WithDebug() Option {
+	return func(jira *Jira) {
+		jira.Debug = true
+	}
+}
+
+// WithDeployment sets Deployment
+func WithDeployment(deployment Deployment) Option {
+	return func(jira *Jira) {
+		jira.Deployment = deployment
+	}
+}
+
+// WithAPIBasePath sets APIBasePath
+func WithAPIBasePath(basePath string) Option {
+	return func(jira *Jira) {
+		jira.APIBasePath = basePath
+	}
+}
+
+// WithTimeout sets Timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(jira *Jira) {
+		jira.Timeout = timeout
+	}
+}
+
+// WithProxyURL sets ProxyURL
+func WithProxyURL(proxyURL *url.URL) Option {
+	return func(jira *Jira) {
+		jira.ProxyURL = proxyURL
+	}
+}
+
+// WithTokenSource sets TokenSource
+func WithTokenSource(source TokenSource) Option {
+	return func(jira *Jira) {
+		jira.TokenSource = source
+	}
+}
+
+// WithDryRun enables DryRun
+func WithDryRun() Option {
+	return func(jira *Jira) {
+		jira.DryRun = true
+	}
+}
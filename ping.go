@@ -0,0 +1,37 @@
+package jirardeau
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnreachable returned by Ping if JIRA doesn't respond
+	ErrUnreachable = errors.New("JIRA is unreachable")
+	// ErrServerError returned by Ping if JIRA responds with 5xx
+	ErrServerError = errors.New("JIRA server error")
+)
+
+// Ping checks that JIRA is reachable and credentials are accepted by requesting current user
+// Error matches ErrUnreachable, ErrInvalidCredentials for 401 and 403 or ErrServerError for 5xx,
+// underlying network error or APIError is kept in chain
+func (jira *Jira) Ping() error {
+	_, err := jira.request("GET", "/myself", nil)
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return errors.Wrap(withCause(ErrUnreachable, err), "failed ping")
+	}
+	switch {
+	case apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden:
+		return errors.Wrap(withCause(ErrInvalidCredentials, err), "failed ping")
+	case apiErr.StatusCode >= 500:
+		return errors.Wrap(withCause(ErrServerError, err), "failed ping")
+	}
+
+	return errors.Wrap(err, "failed ping")
+}
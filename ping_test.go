@@ -0,0 +1,68 @@
+package jirardeau
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestPing(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		sentinel   error
+	}{
+		{statusCode: http.StatusOK},
+		{statusCode: http.StatusUnauthorized, sentinel: ErrInvalidCredentials},
+		{statusCode: http.StatusForbidden, sentinel: ErrInvalidCredentials},
+		{statusCode: http.StatusInternalServerError, sentinel: ErrServerError},
+		{statusCode: http.StatusServiceUnavailable, sentinel: ErrServerError},
+	}
+
+	for _, test := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.statusCode)
+			w.Write([]byte(`{"name":"jdoe"}`))
+		}))
+
+		jira, err := NewJira(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = jira.Ping()
+		server.Close()
+
+		if test.sentinel == nil {
+			if err != nil {
+				t.Errorf("%d: unexpected error %v", test.statusCode, err)
+			}
+			continue
+		}
+		var apiErr *APIError
+		if !errors.Is(err, test.sentinel) || !errors.As(err, &apiErr) || apiErr.StatusCode != test.statusCode {
+			t.Errorf("%d: expected %v with APIError, got %v", test.statusCode, test.sentinel, err)
+		}
+	}
+}
+
+func TestPingUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	jira, err := NewJira("http://" + addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = jira.Ping()
+	var opErr *net.OpError
+	if !errors.Is(err, ErrUnreachable) || !errors.As(err, &opErr) {
+		t.Errorf("expected ErrUnreachable with net.OpError, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package jirardeau
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Priority of Issue like Highest, High, Medium, Low, Lowest
+// Priority is referenced by ID or Name when issue is created or updated
+type Priority struct {
+	ID          string `json:"id,omitempty"`
+	Self        string `json:"self,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IconURL     string `json:"iconUrl,omitempty"`
+}
+
+// GetPriorities returns priorities available on JIRA
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/priority-getPriorities
+func (jira *Jira) GetPriorities() (priorities []Priority, err error) {
+	resp, err := jira.request("GET", "/priority", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get priorities")
+	}
+
+	err = json.NewDecoder(resp).Decode(&priorities)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get priorities, failed to decode response")
+	}
+
+	return priorities, nil
+}
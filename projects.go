@@ -0,0 +1,44 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// GetProjects returns all projects visible to user
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/project-getAllProjects
+func (jira *Jira) GetProjects() (projects []Project, err error) {
+	resp, err := jira.request("GET", "/project", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get projects")
+	}
+
+	err = json.NewDecoder(resp).Decode(&projects)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get projects, failed to decode response")
+	}
+
+	return projects, nil
+}
+
+// GetProject returns project by id/key with lead, description and project type
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/project-getProject
+func (jira *Jira) GetProject(key string) (project Project, err error) {
+	if key == "" {
+		return project, errors.New("failed get project: project Key is empty")
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/project/%s", key), nil)
+	if err != nil {
+		return project, errors.Wrap(err, "failed get project")
+	}
+
+	err = json.NewDecoder(resp).Decode(&project)
+	if err != nil {
+		return project, errors.Wrap(err, "failed get project, failed to decode response")
+	}
+
+	return project, nil
+}
@@ -0,0 +1,72 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPropertyNotFound returned by GetIssueProperty if property is not set on issue
+var ErrPropertyNotFound = errors.New("property not found")
+
+// SetIssueProperty stores value encoded as JSON in property of issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue/{issueIdOrKey}/properties-setProperty
+func (jira *Jira) SetIssueProperty(issueKey, propertyKey string, value interface{}) error {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(value)
+	if err != nil {
+		return errors.Wrapf(err, "failed set property %s of issue %s", propertyKey, issueKey)
+	}
+
+	_, err = jira.request("PUT", fmt.Sprintf("/issue/%s/properties/%s", issueKey, propertyKey), &buf)
+	if err != nil {
+		return errors.Wrapf(err, "failed set property %s of issue %s", propertyKey, issueKey)
+	}
+
+	return nil
+}
+
+// GetIssueProperty decodes value of property of issue into out
+// ErrPropertyNotFound is returned if property is not set, NotFoundError matching ErrIssueNotFound if issue doesn't exist
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue/{issueIdOrKey}/properties-getProperty
+func (jira *Jira) GetIssueProperty(issueKey, propertyKey string, out interface{}) error {
+	resp, err := jira.request("GET", fmt.Sprintf("/issue/%s/properties/%s", issueKey, propertyKey), nil)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			if isIssueNotFound(apiErr) {
+				return errors.Wrapf(notFoundError(err, "issue", issueKey), "failed get property %s of issue %s", propertyKey, issueKey)
+			}
+			return errors.Wrapf(ErrPropertyNotFound, "failed get property %s of issue %s", propertyKey, issueKey)
+		}
+		return errors.Wrapf(err, "failed get property %s of issue %s", propertyKey, issueKey)
+	}
+
+	var property struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	err = json.NewDecoder(resp).Decode(&property)
+	if err == nil {
+		err = json.Unmarshal(property.Value, out)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed get property %s of issue %s, failed to decode response", propertyKey, issueKey)
+	}
+
+	return nil
+}
+
+// DeleteIssueProperty removes property of issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue/{issueIdOrKey}/properties-deleteProperty
+func (jira *Jira) DeleteIssueProperty(issueKey, propertyKey string) error {
+	_, err := jira.request("DELETE", fmt.Sprintf("/issue/%s/properties/%s", issueKey, propertyKey), nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed delete property %s of issue %s", propertyKey, issueKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package jirardeau
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestGetIssuePropertyNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		if strings.Contains(r.URL.Path, "/TEST-404/") {
+			w.Write([]byte(`{"errorMessages":["Issue Does Not Exist"],"errors":{}}`))
+			return
+		}
+		w.Write([]byte(`{"errorMessages":["The property with key 'sync' does not exist."],"errors":{}}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var value string
+	err = jira.GetIssueProperty("TEST-1", "sync", &value)
+	if !errors.Is(err, ErrPropertyNotFound) {
+		t.Errorf("expected ErrPropertyNotFound, got %v", err)
+	}
+
+	err = jira.GetIssueProperty("TEST-404", "sync", &value)
+	if !errors.Is(err, ErrIssueNotFound) || errors.Is(err, ErrPropertyNotFound) {
+		t.Errorf("expected ErrIssueNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package jirardeau
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// ReleaseNotesFormat is format of release notes rendered by default template
+type ReleaseNotesFormat string
+
+const (
+	// ReleaseNotesMarkdown renders release notes as Markdown
+	ReleaseNotesMarkdown ReleaseNotesFormat = "markdown"
+	// ReleaseNotesText renders release notes as plain text
+	ReleaseNotesText ReleaseNotesFormat = "text"
+)
+
+// ReleaseNotesOptions configures GenerateReleaseNotes
+// Template is executed with ReleaseNotes if set, otherwise default template of Format is used,
+// ReleaseNotesMarkdown is used if Format is empty
+type ReleaseNotesOptions struct {
+	Format   ReleaseNotesFormat
+	Template *template.Template
+}
+
+// ReleaseNotes holds issues of version grouped by issue type
+type ReleaseNotes struct {
+	Version FixVersion
+	Groups  []ReleaseNotesGroup
+}
+
+// ReleaseNotesGroup holds issues of one issue type sorted by key
+type ReleaseNotesGroup struct {
+	IssueType string
+	Issues    []Issue
+}
+
+// releaseNotesOtherType is group of issues without issue type
+const releaseNotesOtherType = "Other"
+
+var releaseNotesTemplates = map[ReleaseNotesFormat]*template.Template{
+	ReleaseNotesMarkdown: template.Must(template.New("markdown").Parse(
+		`## Release {{.Version.Name}}
+{{range .Groups}}
+### {{.IssueType}}
+{{range .Issues}}- {{.Key}} {{.Fields.Summary}}
+{{end}}{{end}}`)),
+	ReleaseNotesText: template.Must(template.New("text").Parse(
+		`Release {{.Version.Name}}
+{{range .Groups}}
+{{.IssueType}}:
+{{range .Issues}}  {{.Key}} {{.Fields.Summary}}
+{{end}}{{end}}`)),
+}
+
+// GenerateReleaseNotes returns issues of fixVersion rendered as release notes grouped by issue type
+func (jira *Jira) GenerateReleaseNotes(fixVersion FixVersion, opts ReleaseNotesOptions) (notes string, err error) {
+	tmpl := opts.Template
+	if tmpl == nil {
+		format := opts.Format
+		if format == "" {
+			format = ReleaseNotesMarkdown
+		}
+		var ok bool
+		tmpl, ok = releaseNotesTemplates[format]
+		if !ok {
+			return "", errors.Errorf("failed generate release notes: unknown format %q", format)
+		}
+	}
+
+	issues, err := jira.GetIssues(fixVersion)
+	if err != nil {
+		return "", errors.Wrap(err, "failed generate release notes")
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, newReleaseNotes(fixVersion, issues))
+	if err != nil {
+		return "", errors.Wrap(err, "failed generate release notes")
+	}
+
+	return buf.String(), nil
+}
+
+// newReleaseNotes groups issues by issue type name, groups are sorted by name with issues without type last
+func newReleaseNotes(fixVersion FixVersion, issues map[string]Issue) ReleaseNotes {
+	byType := make(map[string][]Issue)
+	for _, issue := range issues {
+		if issue.Fields == nil {
+			issue.Fields = &IssueFields{}
+		}
+		issueType := releaseNotesOtherType
+		if issue.Fields.IssueType != nil && issue.Fields.IssueType.Name != "" {
+			issueType = issue.Fields.IssueType.Name
+		}
+		byType[issueType] = append(byType[issueType], issue)
+	}
+
+	notes := ReleaseNotes{Version: fixVersion}
+	for issueType, typeIssues := range byType {
+		sort.Slice(typeIssues, func(i, j int) bool {
+			return issueKeyLess(typeIssues[i].Key, typeIssues[j].Key)
+		})
+		notes.Groups = append(notes.Groups, ReleaseNotesGroup{IssueType: issueType, Issues: typeIssues})
+	}
+	sort.Slice(notes.Groups, func(i, j int) bool {
+		if notes.Groups[i].IssueType == releaseNotesOtherType || notes.Groups[j].IssueType == releaseNotesOtherType {
+			return notes.Groups[j].IssueType == releaseNotesOtherType
+		}
+		return notes.Groups[i].IssueType < notes.Groups[j].IssueType
+	})
+
+	return notes
+}
+
+// issueKeyLess compares issue keys like PROJ-9 and PROJ-10 by project and then by number
+func issueKeyLess(a, b string) bool {
+	aProject, aNumber := splitIssueKey(a)
+	bProject, bNumber := splitIssueKey(b)
+	if aProject != bProject {
+		return aProject < bProject
+	}
+	if aNumber != bNumber {
+		return aNumber < bNumber
+	}
+	return a < b
+}
+
+// splitIssueKey returns project key and number of issue key, number is -1 if key has no number
+func splitIssueKey(key string) (project string, number int) {
+	i := strings.LastIndex(key, "-")
+	if i < 0 {
+		return key, -1
+	}
+	number, err := strconv.Atoi(key[i+1:])
+	if err != nil {
+		return key, -1
+	}
+	return key[:i], number
+}
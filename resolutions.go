@@ -0,0 +1,23 @@
+package jirardeau
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// GetResolutions returns resolutions available on JIRA, e.g. Fixed, Won't Fix
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/resolution-getResolutions
+func (jira *Jira) GetResolutions() (resolutions []Resolution, err error) {
+	resp, err := jira.request("GET", "/resolution", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get resolutions")
+	}
+
+	err = json.NewDecoder(resp).Decode(&resolutions)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get resolutions, failed to decode response")
+	}
+
+	return resolutions, nil
+}
@@ -0,0 +1,119 @@
+package jirardeau
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy configures retries of requests failed with 429, 502, 503, 504 HTTP codes
+// or with temporary network errors.
+// Delay between attempts starts from BaseDelay and doubles on every attempt up to MaxDelay,
+// Retry-After header of response is used as delay if present.
+// Non-idempotent requests (POST, PATCH) are retried only if request was not sent,
+// e.g. connection was refused, or JIRA responded with 429 Too Many Requests.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// retryable reports whether failed attempt should be retried
+func (policy *RetryPolicy) retryable(method string, attempt int, resp *http.Response, err error) bool {
+	if policy == nil || attempt >= policy.MaxAttempts {
+		return false
+	}
+	if resp == nil {
+		// JIRA may have processed request which timed out or lost connection
+		if method == "POST" || method == "PATCH" {
+			return err != nil && isNotSent(err)
+		}
+		return err != nil && isTemporary(err)
+	}
+	// request is not processed by JIRA when rate limited, so it's safe to retry any method
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return err == nil
+	}
+	if method == "POST" || method == "PATCH" {
+		return false
+	}
+	if err != nil {
+		return isTemporary(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// delay returns duration to wait before next attempt
+func (policy *RetryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	delay := policy.BaseDelay << uint(attempt-1)
+	if policy.MaxDelay > 0 && (delay > policy.MaxDelay || delay < 0) {
+		delay = policy.MaxDelay
+	}
+
+	return delay
+}
+
+// parseRetryAfter parses Retry-After header given in seconds or as HTTP date
+func parseRetryAfter(value string) (delay time.Duration, ok bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	delay = time.Until(date)
+	if delay < 0 {
+		delay = 0
+	}
+
+	return delay, true
+}
+
+// isTemporary reports whether err is network error which may disappear on retry
+func isTemporary(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// isNotSent reports whether err is network error occurred before request was sent
+func isNotSent(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,171 @@
+package jirardeau
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestRetryTooManyRequests(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	jira := &Jira{
+		URL:         server.URL,
+		Log:         log.New(ioutil.Discard, "", 0),
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	}
+
+	issue, err := jira.CreateIssue(RequestCreateIssue{Fields: ModifyIssueFields{Summary: "s"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Key != "TEST-1" || atomic.LoadInt32(&hits) != 2 {
+		t.Errorf("unexpected key %q after %d attempts", issue.Key, hits)
+	}
+}
+
+func TestRateLimitError(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		min, max   time.Duration
+	}{
+		{name: "seconds", retryAfter: "120", min: 120 * time.Second, max: 120 * time.Second},
+		{name: "HTTP date", retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), min: 59 * time.Minute, max: time.Hour},
+	}
+
+	for _, test := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", test.retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+
+		jira := &Jira{URL: server.URL, Log: log.New(ioutil.Discard, "", 0)}
+
+		_, err := jira.GetIssue("TEST-1", nil)
+		server.Close()
+
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) {
+			t.Errorf("%s: expected RateLimitError, got %v", test.name, err)
+			continue
+		}
+		if rateLimitErr.RetryAfter < test.min || rateLimitErr.RetryAfter > test.max {
+			t.Errorf("%s: RetryAfter %s is not in [%s, %s]", test.name, rateLimitErr.RetryAfter, test.min, test.max)
+		}
+	}
+}
+
+func TestRetryTimeout(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	jira, err := NewJira(server.URL,
+		WithTimeout(50*time.Millisecond),
+		WithRetryPolicy(&RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = jira.CreateIssue(RequestCreateIssue{Fields: ModifyIssueFields{Summary: "s"}})
+	if err == nil {
+		t.Fatal("expected error of timed out POST")
+	}
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("timed out POST sent %d times, want 1", got)
+	}
+
+	atomic.StoreInt32(&hits, 0)
+	_, err = jira.GetIssue("TEST-1", nil)
+	if err == nil {
+		t.Fatal("expected error of timed out GET")
+	}
+	if got := atomic.LoadInt32(&hits); got != 3 {
+		t.Errorf("timed out GET sent %d times, want 3", got)
+	}
+}
+
+func TestRetryConnectionRefused(t *testing.T) {
+	var dials int32
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			atomic.AddInt32(&dials, 1)
+			return nil, &net.OpError{Op: "dial", Net: network, Err: syscall.ECONNREFUSED}
+		},
+	}}
+
+	jira, err := NewJira("http://jira.example.com",
+		WithHTTPClient(client),
+		WithRetryPolicy(&RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = jira.CreateIssue(RequestCreateIssue{Fields: ModifyIssueFields{Summary: "s"}})
+	if err == nil {
+		t.Fatal("expected error of refused connection")
+	}
+	if got := atomic.LoadInt32(&dials); got != 3 {
+		t.Errorf("refused POST dialed %d times, want 3", got)
+	}
+}
+
+func TestRetryBadGateway(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithRetryPolicy(&RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue, err := jira.GetIssue("TEST-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Key != "TEST-1" || atomic.LoadInt32(&hits) != 3 {
+		t.Errorf("unexpected issue %q after %d attempts", issue.Key, hits)
+	}
+
+	atomic.StoreInt32(&hits, 0)
+	_, err = jira.CreateIssue(RequestCreateIssue{Fields: ModifyIssueFields{Summary: "s"}})
+	if err == nil {
+		t.Fatal("expected error of POST failed with 502")
+	}
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("POST failed with 502 sent %d times, want 1", got)
+	}
+}
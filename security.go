@@ -0,0 +1,40 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// SecurityLevel restricts who can see issue, it's referenced by ID or Name when issue is created or updated
+type SecurityLevel struct {
+	ID          string `json:"id,omitempty"`
+	Self        string `json:"self,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetSecurityLevels returns security levels of project available for current user,
+// empty slice is returned for project without issue security scheme
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/project/{projectKeyOrId}/securitylevel-getSecurityLevelsForProject
+func (jira *Jira) GetSecurityLevels(projectKey string) (levels []SecurityLevel, err error) {
+	var result struct {
+		Levels []SecurityLevel `json:"levels"`
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/project/%s/securitylevel", projectKey), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get security levels")
+	}
+
+	err = json.NewDecoder(resp).Decode(&result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get security levels, failed to decode response")
+	}
+	if result.Levels == nil {
+		result.Levels = []SecurityLevel{}
+	}
+
+	return result.Levels, nil
+}
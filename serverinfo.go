@@ -0,0 +1,54 @@
+package jirardeau
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// ServerInfo holds JIRA version and deployment type
+// DeploymentType is "Cloud" for Jira Cloud and "Server" for JIRA Server, empty on old JIRA Server versions
+type ServerInfo struct {
+	BaseURL        string `json:"baseUrl"`
+	Version        string `json:"version"`
+	VersionNumbers []int  `json:"versionNumbers"`
+	DeploymentType string `json:"deploymentType"`
+	BuildNumber    int    `json:"buildNumber"`
+	BuildDate      string `json:"buildDate"`
+	ServerTime     string `json:"serverTime"`
+	ScmInfo        string `json:"scmInfo"`
+	ServerTitle    string `json:"serverTitle"`
+}
+
+// GetServerInfo returns JIRA version and deployment type
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/serverInfo-getServerInfo
+func (jira *Jira) GetServerInfo() (info ServerInfo, err error) {
+	resp, err := jira.request("GET", "/serverInfo", nil)
+	if err != nil {
+		return info, errors.Wrap(err, "failed get server info")
+	}
+
+	err = json.NewDecoder(resp).Decode(&info)
+	if err != nil {
+		return info, errors.Wrap(err, "failed get server info, failed to decode response")
+	}
+
+	return info, nil
+}
+
+// DetectDeployment sets Deployment by deployment type returned by GetServerInfo,
+// DeploymentServer is set if JIRA doesn't report deployment type.
+// DetectDeployment changes Jira so it must be called before Jira is used by multiple goroutines
+func (jira *Jira) DetectDeployment() (deployment Deployment, err error) {
+	info, err := jira.GetServerInfo()
+	if err != nil {
+		return "", errors.Wrap(err, "failed detect deployment")
+	}
+
+	jira.Deployment = DeploymentServer
+	if Deployment(info.DeploymentType) == DeploymentCloud {
+		jira.Deployment = DeploymentCloud
+	}
+
+	return jira.Deployment, nil
+}
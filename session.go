@@ -0,0 +1,142 @@
+package jirardeau
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// sessionAuth holds JIRA session cookie and credentials used to renew expired session
+type sessionAuth struct {
+	cookie   *http.Cookie
+	login    string
+	password string
+}
+
+// Authenticate creates JIRA session, session cookie is sent instead of other credentials until Logout.
+// Expired session is renewed with login and password once JIRA responds with 401.
+// ErrInvalidCredentials is returned if JIRA rejects login and password
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#auth/1/session-login
+func (jira *Jira) Authenticate(login, password string) error {
+	cookie, err := jira.createSession(login, password)
+	if err != nil {
+		return errors.Wrap(err, "failed authenticate")
+	}
+
+	jira.mu.Lock()
+	jira.session = &sessionAuth{
+		cookie:   cookie,
+		login:    login,
+		password: password,
+	}
+	jira.mu.Unlock()
+
+	return nil
+}
+
+// Logout removes JIRA session created by Authenticate, session cookie isn't sent anymore even if Logout fails
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#auth/1/session-logout
+func (jira *Jira) Logout() error {
+	if jira.sessionCookie() == nil {
+		return errors.New("failed logout: not authenticated")
+	}
+
+	_, err := jira.requestAPI(context.Background(), AuthBasePath, "DELETE", "/session", nil, nil)
+
+	jira.mu.Lock()
+	jira.session = nil
+	jira.mu.Unlock()
+
+	if err != nil {
+		return errors.Wrap(err, "failed logout")
+	}
+
+	return nil
+}
+
+// createSession requests new session and returns its cookie
+// request isn't retried and session isn't renewed on 401 to avoid recursion
+func (jira *Jira) createSession(login, password string) (cookie *http.Cookie, err error) {
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(map[string]string{
+		"username": login,
+		"password": password,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	absURL := joinURL(jira.serverURL(), AuthBasePath, "/session")
+	jira.logger().Info("STRT", "POST", absURL)
+	start := time.Now()
+
+	resp, respBuf, err := jira.send(context.Background(), "POST", absURL, buf.Bytes(), nil)
+	if err != nil {
+		jira.logger().Error(err, "in", elapsed(start))
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		apiErr := newAPIError("POST", absURL, resp.StatusCode, respBuf.Bytes())
+		jira.logger().Error(apiErr, "in", elapsed(start))
+		if resp.StatusCode == http.StatusUnauthorized {
+			return nil, withCause(ErrInvalidCredentials, apiErr)
+		}
+		return nil, apiErr
+	}
+
+	var result struct {
+		Session struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"session"`
+	}
+	err = json.Unmarshal(respBuf.Bytes(), &result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode response")
+	}
+	if result.Session.Name == "" || result.Session.Value == "" {
+		return nil, errors.New("session cookie is empty")
+	}
+
+	jira.logger().Info("DONE", "POST", absURL, elapsed(start))
+
+	return &http.Cookie{Name: result.Session.Name, Value: result.Session.Value}, nil
+}
+
+// sessionCookie returns cookie of session created by Authenticate or nil
+func (jira *Jira) sessionCookie() *http.Cookie {
+	jira.mu.Lock()
+	defer jira.mu.Unlock()
+
+	if jira.session == nil {
+		return nil
+	}
+	return jira.session.cookie
+}
+
+// renewSession creates new session with credentials of Authenticate, it returns false if there is no session
+func (jira *Jira) renewSession() (ok bool, err error) {
+	jira.mu.Lock()
+	session := jira.session
+	jira.mu.Unlock()
+	if session == nil {
+		return false, nil
+	}
+
+	cookie, err := jira.createSession(session.login, session.password)
+	if err != nil {
+		return false, errors.Wrap(err, "failed renew session")
+	}
+
+	jira.mu.Lock()
+	if jira.session != nil {
+		jira.session.cookie = cookie
+	}
+	jira.mu.Unlock()
+
+	return true, nil
+}
@@ -0,0 +1,49 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// IssueTypeStatuses holds statuses valid for issue type in project
+type IssueTypeStatuses struct {
+	ID       string   `json:"id"`
+	Self     string   `json:"self"`
+	Name     string   `json:"name"`
+	SubTask  bool     `json:"subtask"`
+	Statuses []Status `json:"statuses"`
+}
+
+// GetStatuses returns all statuses available on JIRA
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/status-getStatuses
+func (jira *Jira) GetStatuses() (statuses []Status, err error) {
+	resp, err := jira.request("GET", "/status", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get statuses")
+	}
+
+	err = json.NewDecoder(resp).Decode(&statuses)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get statuses, failed to decode response")
+	}
+
+	return statuses, nil
+}
+
+// GetStatusesForProject returns statuses of project grouped by issue type
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/project-getAllStatuses
+func (jira *Jira) GetStatusesForProject(projectKey string) (issueTypes []IssueTypeStatuses, err error) {
+	resp, err := jira.request("GET", fmt.Sprintf("/project/%s/statuses", projectKey), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed get statuses of project %s", projectKey)
+	}
+
+	err = json.NewDecoder(resp).Decode(&issueTypes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed get statuses of project %s, failed to decode response", projectKey)
+	}
+
+	return issueTypes, nil
+}
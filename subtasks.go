@@ -0,0 +1,18 @@
+package jirardeau
+
+import (
+	"github.com/pkg/errors"
+)
+
+// GetSubtasks returns sub-tasks of parent issue, empty slice if parent has no sub-tasks
+func (jira *Jira) GetSubtasks(parentKey string) (issues []Issue, err error) {
+	issues, err = jira.SearchJQL("parent = "+quoteJQL(parentKey), nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed get sub-tasks of %s", parentKey)
+	}
+	if issues == nil {
+		issues = []Issue{}
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,98 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Transition holds workflow transition of Issue
+type Transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   Status `json:"to"`
+}
+
+// requestTransition holds body of POST /issue/{key}/transitions
+type requestTransition struct {
+	Transition struct {
+		ID string `json:"id"`
+	} `json:"transition"`
+	Fields ModifyIssueFields `json:"fields"`
+}
+
+// GetTransitions returns transitions available for issue in its current status
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-getTransitions
+func (jira *Jira) GetTransitions(issueKey string) (transitions []Transition, err error) {
+	var result struct {
+		Transitions []Transition `json:"transitions"`
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/issue/%s/transitions", issueKey), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get transitions")
+	}
+
+	err = json.NewDecoder(resp).Decode(&result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get transitions, failed to decode response")
+	}
+
+	return result.Transitions, nil
+}
+
+// DoTransition moves issue through workflow by transition id
+// fields are set during transition, e.g. Resolution required by "Close Issue"
+// fields not allowed on transition screen are rejected by JIRA with *APIError holding FieldErrors
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-doTransition
+func (jira *Jira) DoTransition(issueKey, transitionID string, fields ModifyIssueFields) error {
+	if transitionID == "" {
+		return errors.New("failed do transition: transition ID is empty")
+	}
+
+	fields, err := jira.requestFields(fields)
+	if err != nil {
+		return errors.Wrap(err, "failed do transition")
+	}
+
+	request := requestTransition{
+		Fields: fields,
+	}
+	request.Transition.ID = transitionID
+
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(request)
+	if err != nil {
+		return errors.Wrap(err, "failed do transition")
+	}
+
+	_, err = jira.request("POST", fmt.Sprintf("/issue/%s/transitions", issueKey), &buf)
+	if err != nil {
+		return errors.Wrap(err, "failed do transition")
+	}
+
+	return nil
+}
+
+// TransitionToStatus moves issue to status by name compared case-insensitively,
+// transition leading to the status is looked up among transitions available for issue
+func (jira *Jira) TransitionToStatus(issueKey, statusName string, fields ModifyIssueFields) error {
+	transitions, err := jira.GetTransitions(issueKey)
+	if err != nil {
+		return errors.Wrapf(err, "failed transition issue %s to status %q", issueKey, statusName)
+	}
+
+	available := make([]string, 0, len(transitions))
+	for _, transition := range transitions {
+		if strings.EqualFold(transition.To.Name, statusName) {
+			return jira.DoTransition(issueKey, transition.ID, fields)
+		}
+		available = append(available, fmt.Sprintf("%q to %q", transition.Name, transition.To.Name))
+	}
+
+	return errors.Errorf("failed transition issue %s to status %q: no transition to status, available transitions: %s",
+		issueKey, statusName, strings.Join(available, ", "))
+}
@@ -0,0 +1,61 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidCredentials returned when JIRA rejects Login/Password or AuthToken
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// GetCurrentUser returns authenticated user, can be used to check credentials
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/myself-getUser
+func (jira *Jira) GetCurrentUser() (user Author, err error) {
+	resp, err := jira.request("GET", "/myself", nil)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
+			return user, errors.Wrap(withCause(ErrInvalidCredentials, apiErr), "failed get current user")
+		}
+		return user, errors.Wrap(err, "failed get current user")
+	}
+
+	err = json.NewDecoder(resp).Decode(&user)
+	if err != nil {
+		return user, errors.Wrap(err, "failed get current user, failed to decode response")
+	}
+
+	return user, nil
+}
+
+// SearchUsers returns users matching query by name, display name or email
+// query is sent as "query" parameter on Cloud and as "username" parameter on Server
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/user-findUsers
+func (jira *Jira) SearchUsers(query string) (users []Author, err error) {
+	if query == "" {
+		return nil, errors.New("failed search users: query is empty")
+	}
+
+	parameters := url.Values{}
+	if jira.isCloud() {
+		parameters.Add("query", query)
+	} else {
+		parameters.Add("username", query)
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/user/search?%s", parameters.Encode()), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed search users")
+	}
+
+	err = json.NewDecoder(resp).Decode(&users)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed search users, failed to decode response")
+	}
+
+	return users, nil
+}
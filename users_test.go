@@ -0,0 +1,36 @@
+package jirardeau
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestInvalidCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errorMessages":["Login failed"],"errors":{}}`))
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(server.URL, WithBasicAuth("jdoe", "wrong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = jira.GetCurrentUser()
+	var apiErr *APIError
+	if !errors.Is(err, ErrInvalidCredentials) || !errors.As(err, &apiErr) {
+		t.Errorf("GetCurrentUser: expected ErrInvalidCredentials with APIError, got %v", err)
+	} else if len(apiErr.Messages) != 1 || apiErr.Messages[0] != "Login failed" {
+		t.Errorf("GetCurrentUser: unexpected messages %v", apiErr.Messages)
+	}
+
+	err = jira.Authenticate("jdoe", "wrong")
+	apiErr = nil
+	if !errors.Is(err, ErrInvalidCredentials) || !errors.As(err, &apiErr) {
+		t.Errorf("Authenticate: expected ErrInvalidCredentials with APIError, got %v", err)
+	}
+}
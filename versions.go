@@ -0,0 +1,243 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// requestVersion holds body of version create/update requests
+type requestVersion struct {
+	Name        string `json:"name,omitempty"`
+	Project     string `json:"project,omitempty"`
+	ProjectID   int    `json:"projectId,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	Released    bool   `json:"released,omitempty"`
+}
+
+// CreateFixVersion creates version and returns it with new ID
+// Jira.Project is used if version has no ProjectID
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/version-createVersion
+func (jira *Jira) CreateFixVersion(version FixVersion) (created FixVersion, err error) {
+	if version.Name == "" {
+		return created, errors.New("failed create version: version Name is empty")
+	}
+
+	request := requestVersion{
+		Name:        version.Name,
+		ProjectID:   version.ProjectID,
+		ReleaseDate: version.ReleaseDate,
+		StartDate:   version.StartDate,
+	}
+	if request.ProjectID == 0 {
+		request.Project = jira.Project
+	}
+
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(request)
+	if err != nil {
+		return created, errors.Wrap(err, "failed create version")
+	}
+
+	resp, err := jira.request("POST", "/version", &buf)
+	if err != nil {
+		return created, errors.Wrap(err, "failed create version")
+	}
+
+	err = json.NewDecoder(resp).Decode(&created)
+	if err != nil {
+		return created, errors.Wrap(err, "failed create version, failed to decode response")
+	}
+
+	return created, nil
+}
+
+// ReleaseVersion marks version as released at releaseDate in format yyyy-mm-dd
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/version-updateVersion
+func (jira *Jira) ReleaseVersion(id string, releaseDate string) error {
+	if id == "" {
+		return errors.New("failed release version: version ID is empty")
+	}
+
+	request := requestVersion{
+		ReleaseDate: releaseDate,
+		Released:    true,
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(request)
+	if err != nil {
+		return errors.Wrap(err, "failed release version")
+	}
+
+	_, err = jira.request("PUT", fmt.Sprintf("/version/%s", id), &buf)
+	if err != nil {
+		return errors.Wrap(err, "failed release version")
+	}
+
+	return nil
+}
+
+// AddFixVersion adds version to fix versions of issue keeping existed ones
+// Version is referenced by ID if set, otherwise by Name. PUT body is built as:
+//
+//	{"update": {"fixVersions": [{"add": {"id": "10001"}}]}}
+func (jira *Jira) AddFixVersion(issueKey string, version FixVersion) error {
+	err := jira.updateFixVersions(issueKey, "add", version)
+	if err != nil {
+		return errors.Wrap(err, "failed add fix version")
+	}
+
+	return nil
+}
+
+// RemoveFixVersion removes version from fix versions of issue keeping other ones
+// Version is referenced by ID if set, otherwise by Name
+func (jira *Jira) RemoveFixVersion(issueKey string, version FixVersion) error {
+	err := jira.updateFixVersions(issueKey, "remove", version)
+	if err != nil {
+		return errors.Wrap(err, "failed remove fix version")
+	}
+
+	return nil
+}
+
+func (jira *Jira) updateFixVersions(issueKey, operation string, version FixVersion) error {
+	ref := make(map[string]string)
+	switch {
+	case version.ID != "":
+		ref["id"] = version.ID
+	case version.Name != "":
+		ref["name"] = version.Name
+	default:
+		return errors.New("version ID and Name are empty")
+	}
+
+	return jira.updateIssueOperations(issueKey, map[string][]map[string]interface{}{
+		"fixVersions": {{operation: ref}},
+	})
+}
+
+// ResolveFixVersions returns versions of Jira.Project by names to be set to ModifyIssueFields.FixVersions
+// Versions are looked up by GetFixVersions, so they are cached if FixVersionsCacheTTL is set.
+// Error lists all names without versions
+func (jira *Jira) ResolveFixVersions(names ...string) (versions []*FixVersion, err error) {
+	releases, err := jira.GetFixVersions()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed resolve versions")
+	}
+
+	byName := make(map[string]FixVersion, len(releases))
+	for _, release := range releases {
+		byName[release.Name] = release
+	}
+
+	var missing []string
+	for _, name := range names {
+		release, ok := byName[name]
+		if !ok {
+			missing = append(missing, fmt.Sprintf("%q", name))
+			continue
+		}
+		versions = append(versions, &release)
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf("failed resolve versions: no versions %s in project %s", strings.Join(missing, ", "), jira.Project)
+	}
+
+	return versions, nil
+}
+
+// VersionFilter selects versions returned by GetFixVersionsFiltered
+// Unreleased and not archived versions are always included
+type VersionFilter struct {
+	IncludeReleased bool
+	IncludeArchived bool
+}
+
+// GetFixVersionsFiltered returns versions of Jira.Project matching filter
+// sorted by release date, versions without release date go last in JIRA order
+func (jira *Jira) GetFixVersionsFiltered(filter VersionFilter) (releases []FixVersion, err error) {
+	versions, err := jira.GetFixVersions()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get filtered versions")
+	}
+
+	releases = []FixVersion{}
+	for _, version := range versions {
+		if version.Released && !filter.IncludeReleased {
+			continue
+		}
+		if version.Archived && !filter.IncludeArchived {
+			continue
+		}
+		releases = append(releases, version)
+	}
+
+	// releaseDate is yyyy-mm-dd so dates are compared as strings
+	sort.SliceStable(releases, func(i, j int) bool {
+		if releases[j].ReleaseDate == "" {
+			return releases[i].ReleaseDate != ""
+		}
+		if releases[i].ReleaseDate == "" {
+			return false
+		}
+		return releases[i].ReleaseDate < releases[j].ReleaseDate
+	})
+
+	return releases, nil
+}
+
+// GetUnreleasedVersions returns unreleased and not archived versions of Jira.Project sorted by release date
+func (jira *Jira) GetUnreleasedVersions() (releases []FixVersion, err error) {
+	return jira.GetFixVersionsFiltered(VersionFilter{})
+}
+
+// ErrNoDate returned by date accessors of FixVersion if date is not set, e.g. version is unscheduled
+var ErrNoDate = errors.New("date is not set")
+
+// versionDateFormat is format of ReleaseDate and StartDate
+const versionDateFormat = "2006-01-02"
+
+// ReleaseDateTime returns ReleaseDate as midnight UTC, ErrNoDate is returned with zero time if it's empty
+func (version FixVersion) ReleaseDateTime() (date time.Time, err error) {
+	return parseVersionDate(version.ReleaseDate, time.UTC)
+}
+
+// StartDateTime returns StartDate as midnight UTC, ErrNoDate is returned with zero time if it's empty
+func (version FixVersion) StartDateTime() (date time.Time, err error) {
+	return parseVersionDate(version.StartDate, time.UTC)
+}
+
+// DaysUntilRelease returns number of days from date of now to ReleaseDate, it's negative for past dates
+// ErrNoDate is returned if ReleaseDate is empty
+func (version FixVersion) DaysUntilRelease(now time.Time) (days int, err error) {
+	release, err := parseVersionDate(version.ReleaseDate, now.Location())
+	if err != nil {
+		return 0, err
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// days are rounded as they may be 23 or 25 hours long because of daylight saving time
+	return int(math.Round(release.Sub(today).Hours() / 24)), nil
+}
+
+func parseVersionDate(value string, location *time.Location) (date time.Time, err error) {
+	if value == "" {
+		return date, ErrNoDate
+	}
+
+	date, err = time.ParseInLocation(versionDateFormat, value, location)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed parse version date %q", value)
+	}
+
+	return date, nil
+}
@@ -0,0 +1,55 @@
+package jirardeau
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Votes holds votes of issue
+// Voters is empty if user has no permission to view voters
+type Votes struct {
+	Self     string   `json:"self"`
+	Votes    int      `json:"votes"`
+	HasVoted bool     `json:"hasVoted"`
+	Voters   []Author `json:"voters"`
+}
+
+// GetVotes returns votes of issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-getVotes
+func (jira *Jira) GetVotes(issueKey string) (votes Votes, err error) {
+	resp, err := jira.request("GET", fmt.Sprintf("/issue/%s/votes", issueKey), nil)
+	if err != nil {
+		return votes, errors.Wrap(err, "failed get votes")
+	}
+
+	err = json.NewDecoder(resp).Decode(&votes)
+	if err != nil {
+		return votes, errors.Wrap(err, "failed get votes, failed to decode response")
+	}
+
+	return votes, nil
+}
+
+// AddVote casts vote of current user for issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-addVote
+func (jira *Jira) AddVote(issueKey string) error {
+	_, err := jira.request("POST", fmt.Sprintf("/issue/%s/votes", issueKey), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed add vote")
+	}
+
+	return nil
+}
+
+// RemoveVote removes vote of current user from issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-removeVote
+func (jira *Jira) RemoveVote(issueKey string) error {
+	_, err := jira.request("DELETE", fmt.Sprintf("/issue/%s/votes", issueKey), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed remove vote")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package jirardeau
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// GetWatchers returns users watching issue
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-getIssueWatchers
+func (jira *Jira) GetWatchers(issueKey string) (watchers []Author, err error) {
+	var result struct {
+		WatchCount int      `json:"watchCount"`
+		Watchers   []Author `json:"watchers"`
+	}
+
+	resp, err := jira.request("GET", fmt.Sprintf("/issue/%s/watchers", issueKey), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get watchers")
+	}
+
+	err = json.NewDecoder(resp).Decode(&result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get watchers, failed to decode response")
+	}
+
+	return result.Watchers, nil
+}
+
+// AddWatcher subscribes user to issue
+// username is account id on Cloud
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-addWatcher
+func (jira *Jira) AddWatcher(issueKey, username string) error {
+	if username == "" {
+		return errors.New("failed add watcher: username is empty")
+	}
+
+	// body is username encoded as JSON string, e.g. "jdoe"
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(username)
+	if err != nil {
+		return errors.Wrap(err, "failed add watcher")
+	}
+
+	_, err = jira.request("POST", fmt.Sprintf("/issue/%s/watchers", issueKey), &buf)
+	if err != nil {
+		return errors.Wrap(err, "failed add watcher")
+	}
+
+	return nil
+}
+
+// RemoveWatcher unsubscribes user from issue
+// username is account id on Cloud
+// https://docs.atlassian.com/software/jira/docs/api/REST/7.6.1/#api/2/issue-removeWatcher
+func (jira *Jira) RemoveWatcher(issueKey, username string) error {
+	if username == "" {
+		return errors.New("failed remove watcher: username is empty")
+	}
+
+	parameters := url.Values{}
+	if jira.isCloud() {
+		parameters.Add("accountId", username)
+	} else {
+		parameters.Add("username", username)
+	}
+
+	_, err := jira.request("DELETE", fmt.Sprintf("/issue/%s/watchers?%s", issueKey, parameters.Encode()), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed remove watcher")
+	}
+
+	return nil
+}